@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.taskfleet.io/packages/dymant/kafka"
+	"go.taskfleet.io/packages/eagle"
+	"go.taskfleet.io/services/genesis/client"
+)
+
+// envPrefix is the prefix of environment variables overriding values of the configuration file,
+// e.g. `TASKFLEET__GENESIS__TARGET`.
+const envPrefix = "TASKFLEET"
+
+// Config describes the configuration of the command-line tool.
+type Config struct {
+	// The connection to the Genesis service.
+	Genesis client.Config `json:"genesis"`
+	// The Kafka cluster from which instance events are consumed. Only required for tailing events
+	// via Kafka.
+	Kafka kafka.Config `json:"kafka"`
+	// The Kafka topic to which Genesis publishes instance events.
+	InstanceEventsTopic string `json:"instanceEventsTopic"`
+}
+
+// defaultConfigPath returns the path of the configuration file that is used if no path is given
+// explicitly.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "taskfleet", "config.yaml")
+}
+
+// loadConfig loads the configuration from the YAML file at the given path and overrides its values
+// with environment variables. The file is optional if it is located at the default path.
+func loadConfig(path string) (Config, error) {
+	var config Config
+	sources := []eagle.ConfigSource{eagle.WithEnvironment(envPrefix)}
+	if path != "" {
+		source := eagle.WithYAMLFile(path, path == defaultConfigPath())
+		sources = append([]eagle.ConfigSource{source}, sources...)
+	}
+	if err := eagle.LoadConfig(&config, sources...); err != nil {
+		return Config{}, fmt.Errorf("failed to load configuration: %s", err)
+	}
+
+	// Reading environment variables initializes all optional values, unset values must thus be
+	// reset explicitly
+	tls := &config.Genesis.TLS
+	for _, value := range []**eagle.String{
+		&tls.CACertificate, &tls.ClientCertificate, &tls.ClientCertificateKey,
+	} {
+		if *value != nil && (*value).Value() == "" {
+			*value = nil
+		}
+	}
+	if config.Kafka.Auth != nil && config.Kafka.Auth.Mechanism == "" {
+		config.Kafka.Auth = nil
+	}
+
+	if config.Genesis.Target == "" {
+		return Config{}, fmt.Errorf("no target of the Genesis service configured")
+	}
+	return config, nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant"
+	"go.taskfleet.io/packages/dymant/kafka"
+)
+
+func runEvents(ctx context.Context, env *env, args []string) error {
+	flags := newFlagSet("events", "(-owner <owner> | -kafka) [flags]")
+	owner := flags.String("owner", "", "owner whose instances to watch via the Genesis service")
+	useKafka := flags.Bool("kafka", false, "consume the events of all owners from Kafka")
+	since := flags.Duration("since", 0, "when consuming from Kafka, also print events that "+
+		"were published within the given duration",
+	)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	switch {
+	case *useKafka && *owner != "":
+		return fmt.Errorf("flags -owner and -kafka are mutually exclusive")
+	case *useKafka:
+		return tailKafka(ctx, env, time.Now().Add(-*since))
+	case *owner != "":
+		return watchInstances(ctx, env, *owner)
+	default:
+		return fmt.Errorf("either -owner or -kafka is required")
+	}
+}
+
+// watchInstances prints the running instances of the given owner and, afterwards, the events of
+// the owner's instances as they occur.
+func watchInstances(ctx context.Context, env *env, owner string) error {
+	stream, err := env.client.WatchInstances(ctx, &genesis.WatchInstancesRequest{Owner: owner})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if running := response.GetRunning(); running != nil {
+			details := fmt.Sprintf("%s %s, %s", formatProvider(running.Config.GetCloudProvider()),
+				running.Config.GetZone(), running.Hostname,
+			)
+			row := []string{"-", running.Instance.Id, "running", details}
+			if err := env.printer.printEvent(running, row); err != nil {
+				return err
+			}
+			continue
+		}
+		var event genesis_messages.InstanceEvent
+		if err := response.GetEvent().UnmarshalTo(&event); err != nil {
+			return fmt.Errorf("failed to decode event: %s", err)
+		}
+		if err := env.printer.printEvent(&event, eventRow(&event)); err != nil {
+			return err
+		}
+	}
+}
+
+// tailKafka prints all instance events published to Kafka after the given time until the context
+// is cancelled.
+func tailKafka(ctx context.Context, env *env, since time.Time) error {
+	config := env.config
+	if len(config.Kafka.BootstrapServers) == 0 || config.InstanceEventsTopic == "" {
+		return fmt.Errorf("no Kafka bootstrap servers or instance events topic configured")
+	}
+	id := config.Kafka.ID
+	if id == "" {
+		id = "taskfleet-cli"
+	}
+	client, err := kafka.NewClient(id, config.Kafka.BootstrapServers, nil,
+		config.Kafka.Options()...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create Kafka client: %s", err)
+	}
+
+	// A reader does not join a consumer group, i.e. it neither leaves state in Kafka nor
+	// interferes with other readers
+	reader, err := client.Reader(config.InstanceEventsTopic, since,
+		dymant.MessageTemplate[*genesis_messages.InstanceEvent](),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to read instance events: %s", err)
+	}
+	subscriber := dymant.NewTypedSubscriber[*genesis_messages.InstanceEvent](reader)
+	defer subscriber.Close()
+
+	err = subscriber.Process(ctx,
+		func(ctx context.Context, events []*genesis_messages.InstanceEvent) error {
+			for _, event := range events {
+				if err := env.printer.printEvent(event, eventRow(event)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+	if dymant.IsErrContext(err) && ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// eventRow returns the table row describing the given event.
+func eventRow(event *genesis_messages.InstanceEvent) []string {
+	kind, details := "unknown", ""
+	switch e := event.Event.(type) {
+	case *genesis_messages.InstanceEvent_Created:
+		kind = "created"
+		details = fmt.Sprintf("%s %s, %s, %s",
+			formatProvider(e.Created.Config.GetCloudProvider()), e.Created.Config.GetZone(),
+			e.Created.Hostname, formatResources(e.Created.Resources),
+		)
+	case *genesis_messages.InstanceEvent_CreationFailed:
+		kind = "creation-failed"
+		details = fmt.Sprintf("%s: %s",
+			formatEnum(e.CreationFailed.Reason, "REASON_"), e.CreationFailed.Message,
+		)
+	case *genesis_messages.InstanceEvent_Deleted:
+		kind = "deleted"
+		details = formatEnum(e.Deleted.Reason, "REASON_")
+		if replacement := e.Deleted.Replacement; replacement != nil {
+			details += fmt.Sprintf(", replaced by %s", replacement.Id)
+		}
+	}
+	return []string{formatTime(event.Timestamp), event.Instance.GetId(), kind, details}
+}
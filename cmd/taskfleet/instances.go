@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+//-------------------------------------------------------------------------------------------------
+// CREATE
+//-------------------------------------------------------------------------------------------------
+
+func runCreate(ctx context.Context, env *env, args []string) error {
+	flags := newFlagSet("create", "-owner <owner> -component <component> -zone <zone> [flags]")
+	id := flags.String("id", "", "ID of the instance, generated randomly if not set")
+	owner := flags.String("owner", "", "owner of the instance (required)")
+	component := flags.String("component", "", "component of the instance (required)")
+	provider := flags.String("provider", "google-cloud-platform", "cloud provider of the instance")
+	zone := flags.String("zone", "", "zone in which to create the instance (required)")
+	spot := flags.Bool("spot", false, "whether to create a spot instance")
+	cpus := flags.Uint("cpus", 1, "minimum number of CPUs")
+	memory := flags.Uint("memory", 1024, "minimum amount of memory in MiB")
+	gpuKind := flags.String("gpu-kind", "", "kind of GPUs to attach, e.g. 'tesla-t4'")
+	gpuCount := flags.Uint("gpu-count", 1, "number of GPUs to attach if a GPU kind is set")
+	hpc := flags.Bool("hpc", false, "whether to prefer compute-optimized instances")
+	maxLifetime := flags.Duration("max-lifetime", 0, "maximum lifetime of the instance")
+	idleTimeout := flags.Duration("idle-timeout", 0, "duration after which idle instances are "+
+		"shut down",
+	)
+	labels := labelsFlag{}
+	flags.Var(labels, "label", "label to attach to the instance as key=value, may be repeated")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "owner", "component", "zone"); err != nil {
+		return err
+	}
+
+	if *id == "" {
+		*id = uuid.NewString()
+	}
+	cloudProvider, err := parseEnum(*provider, "CLOUD_PROVIDER_", genesis.CloudProvider_value)
+	if err != nil {
+		return err
+	}
+	req := &genesis.CreateInstanceRequest{
+		Id:        *id,
+		Owner:     *owner,
+		Component: *component,
+		Config: &genesis.InstanceConfig{
+			CloudProvider: genesis.CloudProvider(cloudProvider),
+			Zone:          *zone,
+			IsSpot:        *spot,
+		},
+		Resources: &genesis.InstanceResources{
+			CpuCount: uint32(*cpus),
+			Memory:   uint32(*memory),
+		},
+		PreferHpc: *hpc,
+		Labels:    labels,
+	}
+	if *gpuKind != "" {
+		kind, err := parseEnum(*gpuKind, "GPU_KIND_", genesis.GPUKind_value)
+		if err != nil {
+			return err
+		}
+		req.Resources.Gpu = &genesis.GPUResources{
+			Kind: genesis.GPUKind(kind), Count: uint32(*gpuCount),
+		}
+	}
+	if *maxLifetime > 0 {
+		req.MaxLifetime = durationpb.New(*maxLifetime)
+	}
+	if *idleTimeout > 0 {
+		req.IdleTimeout = durationpb.New(*idleTimeout)
+	}
+
+	response, err := env.client.CreateInstance(ctx, req)
+	if err != nil {
+		return err
+	}
+	header := []string{"ID", "PROVIDER", "ZONE", "SPOT", "RESOURCES"}
+	return env.printer.print(response, header, func() [][]string {
+		return [][]string{{
+			response.Instance.Id,
+			formatProvider(response.Config.CloudProvider),
+			response.Config.Zone,
+			formatBool(response.Config.IsSpot),
+			formatResources(response.Resources),
+		}}
+	})
+}
+
+//-------------------------------------------------------------------------------------------------
+// LIST
+//-------------------------------------------------------------------------------------------------
+
+func runList(ctx context.Context, env *env, args []string) error {
+	flags := newFlagSet("list", "-owner <owner> [flags]")
+	owner := flags.String("owner", "", "owner of the instances (required)")
+	component := flags.String("component", "", "only list instances of the given component")
+	zone := flags.String("zone", "", "only list instances in the given zone")
+	selector := labelsFlag{}
+	flags.Var(selector, "label", "only list instances with the given label as key=value, may be "+
+		"repeated",
+	)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "owner"); err != nil {
+		return err
+	}
+
+	// Fetch all pages
+	req := &genesis.ListInstancesRequest{
+		Owner:         *owner,
+		Component:     *component,
+		Zone:          *zone,
+		LabelSelector: selector,
+	}
+	response := &genesis.ListInstancesResponse{}
+	for {
+		page, err := env.client.ListInstances(ctx, req)
+		if err != nil {
+			return err
+		}
+		response.Instances = append(response.Instances, page.Instances...)
+		if page.NextPageToken == "" {
+			break
+		}
+		req.PageToken = page.NextPageToken
+	}
+
+	header := []string{
+		"ID", "COMPONENT", "PROVIDER", "ZONE", "SPOT", "RESOURCES", "HOSTNAME", "LAST SEEN",
+		"LABELS",
+	}
+	return env.printer.print(response, header, func() [][]string {
+		return jack.SliceMap(response.Instances, func(i *genesis.RunningInstance) []string {
+			return []string{
+				i.Instance.Id,
+				i.Component,
+				formatProvider(i.Config.GetCloudProvider()),
+				i.Config.GetZone(),
+				formatBool(i.Config.GetIsSpot()),
+				formatResources(i.Resources),
+				i.Hostname,
+				formatTime(i.LastSeen),
+				formatLabels(i.Labels),
+			}
+		})
+	})
+}
+
+//-------------------------------------------------------------------------------------------------
+// SHUTDOWN
+//-------------------------------------------------------------------------------------------------
+
+func runShutdown(ctx context.Context, env *env, args []string) error {
+	flags := newFlagSet("shutdown", "<id>...")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return fmt.Errorf("no instance given")
+	}
+
+	for _, id := range flags.Args() {
+		instance := &genesis.Instance{Id: id}
+		_, err := env.client.ShutdownInstance(ctx, &genesis.ShutdownInstanceRequest{
+			Instance: instance,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to shut down instance %s: %w", id, err)
+		}
+		err = env.printer.print(instance, []string{"ID", "STATUS"}, func() [][]string {
+			return [][]string{{id, "shut down"}}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// UTILITIES
+//-------------------------------------------------------------------------------------------------
+
+// labelsFlag is a flag which may be passed multiple times to set key-value pairs.
+type labelsFlag map[string]string
+
+func (f labelsFlag) String() string {
+	return formatLabels(f)
+}
+
+func (f labelsFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("label %q must be formatted as key=value", value)
+	}
+	f[key] = val
+	return nil
+}
+
+// parseEnum parses the value of an enum from its formatted name (see `formatEnum`).
+func parseEnum(value, prefix string, values map[string]int32) (int32, error) {
+	name := prefix + strings.ToUpper(strings.ReplaceAll(value, "-", "_"))
+	result, ok := values[name]
+	if !ok || result == 0 {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return result, nil
+}
@@ -0,0 +1,128 @@
+// Command taskfleet allows to operate the Genesis service from the command line. It lists zones,
+// creates, lists and shuts down instances, and tails instance events.
+//
+// The connection to Genesis is configured via a YAML file (see `Config`) whose values may be
+// overridden by environment variables prefixed with `TASKFLEET__`.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"go.taskfleet.io/services/genesis/client"
+)
+
+// command is a subcommand of the command-line tool.
+type command struct {
+	name        string
+	description string
+	run         func(ctx context.Context, env *env, args []string) error
+}
+
+// env provides the dependencies shared by all commands.
+type env struct {
+	config  Config
+	client  *client.Client
+	printer *printer
+}
+
+var commands = []command{
+	{"zones", "List the zones in which instances can be created", runZones},
+	{"create", "Create an instance", runCreate},
+	{"list", "List the running instances of an owner", runList},
+	{"shutdown", "Shut down instances", runShutdown},
+	{"events", "Tail instance events", runEvents},
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := run(ctx, os.Args[1:], os.Stdout); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "taskfleet: %s\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// run parses the given arguments and executes the requested command, writing its output to the
+// provided writer.
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("taskfleet", flag.ContinueOnError)
+	configPath := flags.String("config", defaultConfigPath(), "path to the configuration file")
+	output := flags.String("output", formatTable, "output format, either 'table' or 'json'")
+	flags.Usage = func() {
+		w := flags.Output()
+		fmt.Fprintf(w, "Usage: taskfleet [flags] <command> [command flags]\n\nCommands:\n")
+		for _, c := range commands {
+			fmt.Fprintf(w, "  %-10s %s\n", c.name, c.description)
+		}
+		fmt.Fprintf(w, "\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("no command given")
+	}
+
+	cmd, ok := findCommand(flags.Arg(0))
+	if !ok {
+		return fmt.Errorf("unknown command %q", flags.Arg(0))
+	}
+	printer, err := newPrinter(*output, stdout)
+	if err != nil {
+		return err
+	}
+	config, err := loadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+	genesisClient, err := client.Dial(ctx, config.Genesis)
+	if err != nil {
+		return err
+	}
+	defer genesisClient.Close() // nolint:errcheck
+
+	env := &env{config: config, client: genesisClient, printer: printer}
+	return cmd.run(ctx, env, flags.Args()[1:])
+}
+
+func findCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+// newFlagSet returns the flag set for the given command.
+func newFlagSet(name, usage string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: taskfleet %s %s\n\nFlags:\n", name, usage)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// requireFlags returns an error if any of the given string flags is empty.
+func requireFlags(flags *flag.FlagSet, names ...string) error {
+	for _, name := range names {
+		if strings.TrimSpace(flags.Lookup(name).Value.String()) == "" {
+			return fmt.Errorf("flag -%s is required", name)
+		}
+	}
+	return nil
+}
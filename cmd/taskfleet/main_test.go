@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/mercury"
+	"go.taskfleet.io/services/genesis/client"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/provider/fake"
+	"go.taskfleet.io/services/genesis/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestInstanceCommands(t *testing.T) {
+	f := newCommandFixture(t)
+
+	// Zones must be listed as table
+	output, err := f.execute(formatTable, "zones")
+	require.Nil(t, err)
+	assert.Contains(t, output, "google-cloud-platform  europe-west1-b  tesla-t4")
+
+	// Created instances must be returned as JSON
+	output, err = f.execute(formatJSON, "create", "-owner", "owner", "-component", "worker",
+		"-zone", "europe-west1-b", "-cpus", "4", "-gpu-kind", "tesla-t4", "-label", "job=train",
+	)
+	require.Nil(t, err)
+	var response genesis.CreateInstanceResponse
+	require.Nil(t, protojson.Unmarshal([]byte(output), &response))
+	id := response.Instance.Id
+	assert.Equal(t, genesis.GPUKind_GPU_KIND_TESLA_T4, response.Resources.Gpu.Kind)
+
+	// The instance must be listed once it is running
+	require.Eventually(t, func() bool {
+		output, err = f.execute(formatTable, "list", "-owner", "owner", "-label", "job=train")
+		require.Nil(t, err)
+		return strings.Contains(output, id)
+	}, time.Second, 10*time.Millisecond)
+	assert.Contains(t, output, "4 CPUs, 1024 MiB, 1x tesla-t4")
+	assert.Contains(t, output, "job=train")
+
+	// And it must be shut down
+	output, err = f.execute(formatTable, "shutdown", id)
+	require.Nil(t, err)
+	assert.Contains(t, output, "shut down")
+	output, err = f.execute(formatTable, "list", "-owner", "owner")
+	require.Nil(t, err)
+	assert.NotContains(t, output, id)
+
+	// Invalid invocations must fail
+	_, err = f.execute(formatTable, "create", "-owner", "owner")
+	assert.ErrorContains(t, err, "-component")
+	_, err = f.execute(formatTable, "create", "-owner", "owner", "-component", "worker",
+		"-zone", "europe-west1-b", "-provider", "unknown",
+	)
+	assert.ErrorContains(t, err, "invalid value")
+}
+
+func TestWatchEvents(t *testing.T) {
+	f := newCommandFixture(t)
+	_, err := f.execute(formatTable, "create", "-owner", "owner", "-component", "worker",
+		"-zone", "europe-west1-b",
+	)
+	require.Nil(t, err)
+
+	// Watching instances must print the instance until the context is cancelled
+	ctx, cancel := context.WithTimeout(f.ctx, 500*time.Millisecond)
+	defer cancel()
+	f.ctx = ctx
+	output, err := f.execute(formatTable, "events", "-owner", "owner")
+	require.Nil(t, err)
+	assert.Regexp(t, "(created|running) +google-cloud-platform europe-west1-b", output)
+
+	// Tailing events requires a source
+	_, err = f.execute(formatTable, "events")
+	assert.NotNil(t, err)
+}
+
+func TestLoadConfig(t *testing.T) {
+	require.Nil(t, os.Setenv("TASKFLEET__GENESIS__TARGET", "localhost:50051"))
+	defer os.Unsetenv("TASKFLEET__GENESIS__TARGET") // nolint:errcheck
+
+	config, err := loadConfig("testdata/config.yaml")
+	require.Nil(t, err)
+	assert.Equal(t, "localhost:50051", config.Genesis.Target)
+	assert.Nil(t, config.Genesis.TLS.CACertificate)
+	assert.Nil(t, config.Kafka.Auth)
+	assert.Equal(t, []string{"kafka:9092"}, config.Kafka.BootstrapServers)
+	assert.Equal(t, "genesis-instance-events", config.InstanceEventsTopic)
+
+	_, err = loadConfig("testdata/missing.yaml")
+	assert.NotNil(t, err)
+}
+
+//-------------------------------------------------------------------------------------------------
+
+type commandFixture struct {
+	t      *testing.T
+	ctx    context.Context
+	client *client.Client
+}
+
+func newCommandFixture(t *testing.T) *commandFixture {
+	ctx, cancel := context.WithTimeout(zeus.WithNopLogger(context.Background()), 10*time.Second)
+	t.Cleanup(cancel)
+
+	fakeProvider := fake.NewProvider(genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		provider.Zone{
+			Name: "europe-west1-b", GPUs: []genesis.GPUKind{genesis.GPUKind_GPU_KIND_TESLA_T4},
+		},
+	)
+	s, err := service.NewService([]provider.Provider{fakeProvider})
+	require.Nil(t, err)
+	server, err := mercury.NewGrpc(0, mercury.WithRequestValidation())
+	require.Nil(t, err)
+	s.Register(server)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Server.Serve(listener) // nolint:errcheck
+	t.Cleanup(server.Server.Stop)
+
+	runCtx, runCancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(runCtx) // nolint:errcheck
+	}()
+	t.Cleanup(func() {
+		runCancel()
+		<-done
+	})
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		conn.Close() // nolint:errcheck
+	})
+	return &commandFixture{t: t, ctx: ctx, client: client.NewClient(conn)}
+}
+
+// execute runs the command with the given arguments and returns its output in the given format.
+func (f *commandFixture) execute(format string, args ...string) (string, error) {
+	cmd, ok := findCommand(args[0])
+	require.True(f.t, ok)
+
+	var out bytes.Buffer
+	printer, err := newPrinter(format, &out)
+	require.Nil(f.t, err)
+	env := &env{client: f.client, printer: printer}
+	err = cmd.run(f.ctx, env, args[1:])
+	return out.String(), err
+}
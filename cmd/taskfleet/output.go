@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// printer writes the output of commands either as table or as JSON, one message per line.
+type printer struct {
+	format string
+	out    io.Writer
+}
+
+func newPrinter(format string, out io.Writer) (*printer, error) {
+	if format != formatTable && format != formatJSON {
+		return nil, fmt.Errorf("invalid output format %q", format)
+	}
+	return &printer{format: format, out: out}, nil
+}
+
+// print writes the given message as JSON or the given rows as table, depending on the printer's
+// format. Rows are only computed if needed.
+func (p *printer) print(message proto.Message, header []string, rows func() [][]string) error {
+	if p.format == formatJSON {
+		return p.json(message)
+	}
+
+	w := tabwriter.NewWriter(p.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows() {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// printEvent writes the given message as JSON or the given row as a single line, depending on the
+// printer's format. As events are streamed, rows are not aligned.
+func (p *printer) printEvent(message proto.Message, row []string) error {
+	if p.format == formatJSON {
+		return p.json(message)
+	}
+	_, err := fmt.Fprintf(p.out, "%-25s  %-36s  %-15s  %s\n", row[0], row[1], row[2], row[3])
+	return err
+}
+
+// json writes the given message as JSON on a single line.
+func (p *printer) json(message proto.Message) error {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode output: %s", err)
+	}
+	_, err = fmt.Fprintln(p.out, string(data))
+	return err
+}
+
+//-------------------------------------------------------------------------------------------------
+// FORMATTING
+//-------------------------------------------------------------------------------------------------
+
+// formatEnum formats an enum value by stripping the given prefix and lowercasing it.
+func formatEnum(value fmt.Stringer, prefix string) string {
+	name := strings.TrimPrefix(value.String(), prefix)
+	return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
+
+func formatProvider(provider genesis.CloudProvider) string {
+	return formatEnum(provider, "CLOUD_PROVIDER_")
+}
+
+func formatGPUKind(kind genesis.GPUKind) string {
+	return formatEnum(kind, "GPU_KIND_")
+}
+
+func formatResources(resources *genesis.InstanceResources) string {
+	result := fmt.Sprintf("%d CPUs, %d MiB", resources.GetCpuCount(), resources.GetMemory())
+	if gpu := resources.GetGpu(); gpu != nil {
+		result += fmt.Sprintf(", %dx %s", gpu.Count, formatGPUKind(gpu.Kind))
+	}
+	return result
+}
+
+func formatLabels(labels map[string]string) string {
+	keys := maps.Keys(labels)
+	slices.Sort(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + labels[key]
+	}
+	return strings.Join(pairs, ",")
+}
+
+func formatTime(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return "-"
+	}
+	return timestamp.AsTime().Local().Format(time.RFC3339)
+}
+
+func formatBool(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
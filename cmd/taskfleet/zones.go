@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+)
+
+func runZones(ctx context.Context, env *env, args []string) error {
+	flags := newFlagSet("zones", "")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	response, err := env.client.ListZones(ctx, &genesis.ListZonesRequest{})
+	if err != nil {
+		return err
+	}
+	return env.printer.print(response, []string{"PROVIDER", "ZONE", "GPUS"}, func() [][]string {
+		return jack.SliceMap(response.Zones, func(zone *genesis.Zone) []string {
+			gpus := jack.SliceMap(zone.AvailableGpus, formatGPUKind)
+			return []string{formatProvider(zone.Provider), zone.Name, strings.Join(gpus, ",")}
+		})
+	})
+}
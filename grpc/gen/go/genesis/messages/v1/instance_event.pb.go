@@ -83,6 +83,10 @@ const (
 	InstanceDeletedEvent_REASON_UNHEALTHY InstanceDeletedEvent_Reason = 2
 	// The instance was terminated by the cloud provider.
 	InstanceDeletedEvent_REASON_TERMINATED InstanceDeletedEvent_Reason = 3
+	// The spot instance was preempted, i.e. reclaimed by the cloud provider.
+	InstanceDeletedEvent_REASON_PREEMPTED InstanceDeletedEvent_Reason = 4
+	// The instance was shut down as it exceeded its maximum lifetime or its idle timeout.
+	InstanceDeletedEvent_REASON_EXPIRED InstanceDeletedEvent_Reason = 5
 )
 
 // Enum value maps for InstanceDeletedEvent_Reason.
@@ -92,12 +96,16 @@ var (
 		1: "REASON_SHUTDOWN",
 		2: "REASON_UNHEALTHY",
 		3: "REASON_TERMINATED",
+		4: "REASON_PREEMPTED",
+		5: "REASON_EXPIRED",
 	}
 	InstanceDeletedEvent_Reason_value = map[string]int32{
 		"REASON_UNSPECIFIED": 0,
 		"REASON_SHUTDOWN":    1,
 		"REASON_UNHEALTHY":   2,
 		"REASON_TERMINATED":  3,
+		"REASON_PREEMPTED":   4,
+		"REASON_EXPIRED":     5,
 	}
 )
 
@@ -258,6 +266,8 @@ type InstanceCreatedEvent struct {
 	Resources *v1.InstanceResources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
 	// The hostname of the created instance.
 	Hostname string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The user-defined labels passed when creating the instance.
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *InstanceCreatedEvent) Reset() {
@@ -313,6 +323,13 @@ func (x *InstanceCreatedEvent) GetHostname() string {
 	return ""
 }
 
+func (x *InstanceCreatedEvent) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 // InstanceCreationFailedEvent wraps information about an instance that failed to start up.
 type InstanceCreationFailedEvent struct {
 	state         protoimpl.MessageState
@@ -379,6 +396,10 @@ type InstanceDeletedEvent struct {
 
 	// The reason why the instance was deleted.
 	Reason InstanceDeletedEvent_Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=genesis.messages.v1.InstanceDeletedEvent_Reason" json:"reason,omitempty"`
+	// The instance that was requested to replace the deleted instance. Only set if the instance was
+	// preempted and the component's policy requests replacing preempted instances. The replacement
+	// is created like any other instance, i.e. its creation is announced via a separate event.
+	Replacement *v1.Instance `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"`
 }
 
 func (x *InstanceDeletedEvent) Reset() {
@@ -420,6 +441,13 @@ func (x *InstanceDeletedEvent) GetReason() InstanceDeletedEvent_Reason {
 	return InstanceDeletedEvent_REASON_UNSPECIFIED
 }
 
+func (x *InstanceDeletedEvent) GetReplacement() *v1.Instance {
+	if x != nil {
+		return x.Replacement
+	}
+	return nil
+}
+
 var File_genesis_messages_v1_instance_event_proto protoreflect.FileDescriptor
 
 var file_genesis_messages_v1_instance_event_proto_rawDesc = []byte{
@@ -453,7 +481,7 @@ var file_genesis_messages_v1_instance_event_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
 	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x14, 0x49,
+	0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x14, 0x49,
 	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76,
 	0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31,
@@ -464,38 +492,53 @@ var file_genesis_messages_v1_instance_event_proto_rawDesc = []byte{
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0xe8, 0x01, 0x0a, 0x1b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x4f, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x37, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x12, 0x4d, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x06, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a,
-	0x1d, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43,
-	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x53, 0x10, 0x01,
-	0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41,
-	0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x22, 0xc4, 0x01, 0x0a, 0x14,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x62,
-	0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x41, 0x53,
-	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44,
-	0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
-	0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x03, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x6f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x66, 0x6c, 0x65,
-	0x65, 0x74, 0x2e, 0x69, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
-	0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a,
+	0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe8, 0x01, 0x0a, 0x1b, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x12, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52,
+	0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x53, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x02, 0x22, 0xa7, 0x02, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x48,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30,
+	0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x8c, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x48,
+	0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x45, 0x45, 0x4d, 0x50, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x42,
+	0x42, 0x5a, 0x40, 0x67, 0x6f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e,
+	0x69, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f,
+	0x76, 0x31, 0x3b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -511,7 +554,7 @@ func file_genesis_messages_v1_instance_event_proto_rawDescGZIP() []byte {
 }
 
 var file_genesis_messages_v1_instance_event_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_genesis_messages_v1_instance_event_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_genesis_messages_v1_instance_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_genesis_messages_v1_instance_event_proto_goTypes = []interface{}{
 	(InstanceCreationFailedEvent_Reason)(0), // 0: genesis.messages.v1.InstanceCreationFailedEvent.Reason
 	(InstanceDeletedEvent_Reason)(0),        // 1: genesis.messages.v1.InstanceDeletedEvent.Reason
@@ -519,26 +562,29 @@ var file_genesis_messages_v1_instance_event_proto_goTypes = []interface{}{
 	(*InstanceCreatedEvent)(nil),            // 3: genesis.messages.v1.InstanceCreatedEvent
 	(*InstanceCreationFailedEvent)(nil),     // 4: genesis.messages.v1.InstanceCreationFailedEvent
 	(*InstanceDeletedEvent)(nil),            // 5: genesis.messages.v1.InstanceDeletedEvent
-	(*v1.Instance)(nil),                     // 6: genesis.v1.Instance
-	(*timestamppb.Timestamp)(nil),           // 7: google.protobuf.Timestamp
-	(*v1.InstanceConfig)(nil),               // 8: genesis.v1.InstanceConfig
-	(*v1.InstanceResources)(nil),            // 9: genesis.v1.InstanceResources
+	nil,                                     // 6: genesis.messages.v1.InstanceCreatedEvent.LabelsEntry
+	(*v1.Instance)(nil),                     // 7: genesis.v1.Instance
+	(*timestamppb.Timestamp)(nil),           // 8: google.protobuf.Timestamp
+	(*v1.InstanceConfig)(nil),               // 9: genesis.v1.InstanceConfig
+	(*v1.InstanceResources)(nil),            // 10: genesis.v1.InstanceResources
 }
 var file_genesis_messages_v1_instance_event_proto_depIdxs = []int32{
-	6, // 0: genesis.messages.v1.InstanceEvent.instance:type_name -> genesis.v1.Instance
-	7, // 1: genesis.messages.v1.InstanceEvent.timestamp:type_name -> google.protobuf.Timestamp
-	3, // 2: genesis.messages.v1.InstanceEvent.created:type_name -> genesis.messages.v1.InstanceCreatedEvent
-	4, // 3: genesis.messages.v1.InstanceEvent.creation_failed:type_name -> genesis.messages.v1.InstanceCreationFailedEvent
-	5, // 4: genesis.messages.v1.InstanceEvent.deleted:type_name -> genesis.messages.v1.InstanceDeletedEvent
-	8, // 5: genesis.messages.v1.InstanceCreatedEvent.config:type_name -> genesis.v1.InstanceConfig
-	9, // 6: genesis.messages.v1.InstanceCreatedEvent.resources:type_name -> genesis.v1.InstanceResources
-	0, // 7: genesis.messages.v1.InstanceCreationFailedEvent.reason:type_name -> genesis.messages.v1.InstanceCreationFailedEvent.Reason
-	1, // 8: genesis.messages.v1.InstanceDeletedEvent.reason:type_name -> genesis.messages.v1.InstanceDeletedEvent.Reason
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	7,  // 0: genesis.messages.v1.InstanceEvent.instance:type_name -> genesis.v1.Instance
+	8,  // 1: genesis.messages.v1.InstanceEvent.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 2: genesis.messages.v1.InstanceEvent.created:type_name -> genesis.messages.v1.InstanceCreatedEvent
+	4,  // 3: genesis.messages.v1.InstanceEvent.creation_failed:type_name -> genesis.messages.v1.InstanceCreationFailedEvent
+	5,  // 4: genesis.messages.v1.InstanceEvent.deleted:type_name -> genesis.messages.v1.InstanceDeletedEvent
+	9,  // 5: genesis.messages.v1.InstanceCreatedEvent.config:type_name -> genesis.v1.InstanceConfig
+	10, // 6: genesis.messages.v1.InstanceCreatedEvent.resources:type_name -> genesis.v1.InstanceResources
+	6,  // 7: genesis.messages.v1.InstanceCreatedEvent.labels:type_name -> genesis.messages.v1.InstanceCreatedEvent.LabelsEntry
+	0,  // 8: genesis.messages.v1.InstanceCreationFailedEvent.reason:type_name -> genesis.messages.v1.InstanceCreationFailedEvent.Reason
+	1,  // 9: genesis.messages.v1.InstanceDeletedEvent.reason:type_name -> genesis.messages.v1.InstanceDeletedEvent.Reason
+	7,  // 10: genesis.messages.v1.InstanceDeletedEvent.replacement:type_name -> genesis.v1.Instance
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_genesis_messages_v1_instance_event_proto_init() }
@@ -607,7 +653,7 @@ func file_genesis_messages_v1_instance_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_genesis_messages_v1_instance_event_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
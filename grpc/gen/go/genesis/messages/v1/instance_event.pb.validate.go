@@ -403,6 +403,8 @@ func (m *InstanceCreatedEvent) validate(all bool) error {
 
 	// no validation rules for Hostname
 
+	// no validation rules for Labels
+
 	if len(errors) > 0 {
 		return InstanceCreatedEventMultiError(errors)
 	}
@@ -614,6 +616,35 @@ func (m *InstanceDeletedEvent) validate(all bool) error {
 
 	// no validation rules for Reason
 
+	if all {
+		switch v := interface{}(m.GetReplacement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceDeletedEventValidationError{
+					field:  "Replacement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceDeletedEventValidationError{
+					field:  "Replacement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplacement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceDeletedEventValidationError{
+				field:  "Replacement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return InstanceDeletedEventMultiError(errors)
 	}
@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: genesis/messages/v1/instance_group_event.proto
+
+package genesis_messages
+
+import (
+	v1 "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InstanceGroupEvent encapsulates the data to describe a lifetime event of a group of cloud
+// instances. Events for the individual instances of the group are published as `InstanceEvent`
+// prior to the group's event.
+// * Key: Globally unique group ID
+type InstanceGroupEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The group that the event refers to.
+	Group *v1.InstanceGroup `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// The timestamp of the event.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are assignable to Event:
+	//
+	//	*InstanceGroupEvent_Created
+	//	*InstanceGroupEvent_CreationFailed
+	Event isInstanceGroupEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *InstanceGroupEvent) Reset() {
+	*x = InstanceGroupEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_genesis_messages_v1_instance_group_event_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceGroupEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceGroupEvent) ProtoMessage() {}
+
+func (x *InstanceGroupEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_genesis_messages_v1_instance_group_event_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceGroupEvent.ProtoReflect.Descriptor instead.
+func (*InstanceGroupEvent) Descriptor() ([]byte, []int) {
+	return file_genesis_messages_v1_instance_group_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InstanceGroupEvent) GetGroup() *v1.InstanceGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *InstanceGroupEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (m *InstanceGroupEvent) GetEvent() isInstanceGroupEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *InstanceGroupEvent) GetCreated() *InstanceGroupCreatedEvent {
+	if x, ok := x.GetEvent().(*InstanceGroupEvent_Created); ok {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *InstanceGroupEvent) GetCreationFailed() *InstanceGroupCreationFailedEvent {
+	if x, ok := x.GetEvent().(*InstanceGroupEvent_CreationFailed); ok {
+		return x.CreationFailed
+	}
+	return nil
+}
+
+type isInstanceGroupEvent_Event interface {
+	isInstanceGroupEvent_Event()
+}
+
+type InstanceGroupEvent_Created struct {
+	// The event indicates that all instances of the group were created.
+	Created *InstanceGroupCreatedEvent `protobuf:"bytes,3,opt,name=created,proto3,oneof"`
+}
+
+type InstanceGroupEvent_CreationFailed struct {
+	// The event indicates that the group failed to be created, i.e. none of its instances exist.
+	CreationFailed *InstanceGroupCreationFailedEvent `protobuf:"bytes,4,opt,name=creation_failed,json=creationFailed,proto3,oneof"`
+}
+
+func (*InstanceGroupEvent_Created) isInstanceGroupEvent_Event() {}
+
+func (*InstanceGroupEvent_CreationFailed) isInstanceGroupEvent_Event() {}
+
+// InstanceGroupCreatedEvent wraps information about a group when all of its instances were
+// created.
+type InstanceGroupCreatedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The configuration of all instances of the group.
+	Config *v1.InstanceConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// The available resources of each instance of the group.
+	Resources *v1.InstanceResources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	// The instances of the group.
+	Members []*InstanceGroupMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *InstanceGroupCreatedEvent) Reset() {
+	*x = InstanceGroupCreatedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_genesis_messages_v1_instance_group_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceGroupCreatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceGroupCreatedEvent) ProtoMessage() {}
+
+func (x *InstanceGroupCreatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_genesis_messages_v1_instance_group_event_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceGroupCreatedEvent.ProtoReflect.Descriptor instead.
+func (*InstanceGroupCreatedEvent) Descriptor() ([]byte, []int) {
+	return file_genesis_messages_v1_instance_group_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InstanceGroupCreatedEvent) GetConfig() *v1.InstanceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *InstanceGroupCreatedEvent) GetResources() *v1.InstanceResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *InstanceGroupCreatedEvent) GetMembers() []*InstanceGroupMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// InstanceGroupMember describes a single created instance of a group.
+type InstanceGroupMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The instance.
+	Instance *v1.Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The hostname of the instance.
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+}
+
+func (x *InstanceGroupMember) Reset() {
+	*x = InstanceGroupMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_genesis_messages_v1_instance_group_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceGroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceGroupMember) ProtoMessage() {}
+
+func (x *InstanceGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_genesis_messages_v1_instance_group_event_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceGroupMember.ProtoReflect.Descriptor instead.
+func (*InstanceGroupMember) Descriptor() ([]byte, []int) {
+	return file_genesis_messages_v1_instance_group_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InstanceGroupMember) GetInstance() *v1.Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *InstanceGroupMember) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+// InstanceGroupCreationFailedEvent wraps information about a group that failed to start up.
+type InstanceGroupCreationFailedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reason why the creation of the group failed, i.e. the reason why the first instance of
+	// the group failed to be created.
+	Reason InstanceCreationFailedEvent_Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=genesis.messages.v1.InstanceCreationFailedEvent_Reason" json:"reason,omitempty"`
+	// A message that provides more details on the failure.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *InstanceGroupCreationFailedEvent) Reset() {
+	*x = InstanceGroupCreationFailedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_genesis_messages_v1_instance_group_event_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceGroupCreationFailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceGroupCreationFailedEvent) ProtoMessage() {}
+
+func (x *InstanceGroupCreationFailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_genesis_messages_v1_instance_group_event_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceGroupCreationFailedEvent.ProtoReflect.Descriptor instead.
+func (*InstanceGroupCreationFailedEvent) Descriptor() ([]byte, []int) {
+	return file_genesis_messages_v1_instance_group_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InstanceGroupCreationFailedEvent) GetReason() InstanceCreationFailedEvent_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return InstanceCreationFailedEvent_REASON_UNSPECIFIED
+}
+
+func (x *InstanceGroupCreationFailedEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_genesis_messages_v1_instance_group_event_proto protoreflect.FileDescriptor
+
+var file_genesis_messages_v1_instance_group_event_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x28, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x16, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x02, 0x0a, 0x12, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x2f, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4a, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x60, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0xd0, 0x01, 0x0a, 0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x42, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x20, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4f,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37,
+	0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x6f, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x69, 0x6f, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_genesis_messages_v1_instance_group_event_proto_rawDescOnce sync.Once
+	file_genesis_messages_v1_instance_group_event_proto_rawDescData = file_genesis_messages_v1_instance_group_event_proto_rawDesc
+)
+
+func file_genesis_messages_v1_instance_group_event_proto_rawDescGZIP() []byte {
+	file_genesis_messages_v1_instance_group_event_proto_rawDescOnce.Do(func() {
+		file_genesis_messages_v1_instance_group_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_genesis_messages_v1_instance_group_event_proto_rawDescData)
+	})
+	return file_genesis_messages_v1_instance_group_event_proto_rawDescData
+}
+
+var file_genesis_messages_v1_instance_group_event_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_genesis_messages_v1_instance_group_event_proto_goTypes = []interface{}{
+	(*InstanceGroupEvent)(nil),               // 0: genesis.messages.v1.InstanceGroupEvent
+	(*InstanceGroupCreatedEvent)(nil),        // 1: genesis.messages.v1.InstanceGroupCreatedEvent
+	(*InstanceGroupMember)(nil),              // 2: genesis.messages.v1.InstanceGroupMember
+	(*InstanceGroupCreationFailedEvent)(nil), // 3: genesis.messages.v1.InstanceGroupCreationFailedEvent
+	(*v1.InstanceGroup)(nil),                 // 4: genesis.v1.InstanceGroup
+	(*timestamppb.Timestamp)(nil),            // 5: google.protobuf.Timestamp
+	(*v1.InstanceConfig)(nil),                // 6: genesis.v1.InstanceConfig
+	(*v1.InstanceResources)(nil),             // 7: genesis.v1.InstanceResources
+	(*v1.Instance)(nil),                      // 8: genesis.v1.Instance
+	(InstanceCreationFailedEvent_Reason)(0),  // 9: genesis.messages.v1.InstanceCreationFailedEvent.Reason
+}
+var file_genesis_messages_v1_instance_group_event_proto_depIdxs = []int32{
+	4, // 0: genesis.messages.v1.InstanceGroupEvent.group:type_name -> genesis.v1.InstanceGroup
+	5, // 1: genesis.messages.v1.InstanceGroupEvent.timestamp:type_name -> google.protobuf.Timestamp
+	1, // 2: genesis.messages.v1.InstanceGroupEvent.created:type_name -> genesis.messages.v1.InstanceGroupCreatedEvent
+	3, // 3: genesis.messages.v1.InstanceGroupEvent.creation_failed:type_name -> genesis.messages.v1.InstanceGroupCreationFailedEvent
+	6, // 4: genesis.messages.v1.InstanceGroupCreatedEvent.config:type_name -> genesis.v1.InstanceConfig
+	7, // 5: genesis.messages.v1.InstanceGroupCreatedEvent.resources:type_name -> genesis.v1.InstanceResources
+	2, // 6: genesis.messages.v1.InstanceGroupCreatedEvent.members:type_name -> genesis.messages.v1.InstanceGroupMember
+	8, // 7: genesis.messages.v1.InstanceGroupMember.instance:type_name -> genesis.v1.Instance
+	9, // 8: genesis.messages.v1.InstanceGroupCreationFailedEvent.reason:type_name -> genesis.messages.v1.InstanceCreationFailedEvent.Reason
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_genesis_messages_v1_instance_group_event_proto_init() }
+func file_genesis_messages_v1_instance_group_event_proto_init() {
+	if File_genesis_messages_v1_instance_group_event_proto != nil {
+		return
+	}
+	file_genesis_messages_v1_instance_event_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_genesis_messages_v1_instance_group_event_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceGroupEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_genesis_messages_v1_instance_group_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceGroupCreatedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_genesis_messages_v1_instance_group_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceGroupMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_genesis_messages_v1_instance_group_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceGroupCreationFailedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_genesis_messages_v1_instance_group_event_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*InstanceGroupEvent_Created)(nil),
+		(*InstanceGroupEvent_CreationFailed)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_genesis_messages_v1_instance_group_event_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_genesis_messages_v1_instance_group_event_proto_goTypes,
+		DependencyIndexes: file_genesis_messages_v1_instance_group_event_proto_depIdxs,
+		MessageInfos:      file_genesis_messages_v1_instance_group_event_proto_msgTypes,
+	}.Build()
+	File_genesis_messages_v1_instance_group_event_proto = out.File
+	file_genesis_messages_v1_instance_group_event_proto_rawDesc = nil
+	file_genesis_messages_v1_instance_group_event_proto_goTypes = nil
+	file_genesis_messages_v1_instance_group_event_proto_depIdxs = nil
+}
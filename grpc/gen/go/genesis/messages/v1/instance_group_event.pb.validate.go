@@ -0,0 +1,719 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: genesis/messages/v1/instance_group_event.proto
+
+package genesis_messages
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on InstanceGroupEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InstanceGroupEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceGroupEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InstanceGroupEventMultiError, or nil if none found.
+func (m *InstanceGroupEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceGroupEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceGroupEventValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceGroupEventValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceGroupEventValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceGroupEventValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceGroupEventValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceGroupEventValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Event.(type) {
+	case *InstanceGroupEvent_Created:
+		if v == nil {
+			err := InstanceGroupEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCreated()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InstanceGroupEventValidationError{
+						field:  "Created",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InstanceGroupEventValidationError{
+						field:  "Created",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreated()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InstanceGroupEventValidationError{
+					field:  "Created",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InstanceGroupEvent_CreationFailed:
+		if v == nil {
+			err := InstanceGroupEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCreationFailed()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InstanceGroupEventValidationError{
+						field:  "CreationFailed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InstanceGroupEventValidationError{
+						field:  "CreationFailed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreationFailed()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InstanceGroupEventValidationError{
+					field:  "CreationFailed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return InstanceGroupEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// InstanceGroupEventMultiError is an error wrapping multiple validation errors
+// returned by InstanceGroupEvent.ValidateAll() if the designated constraints
+// aren't met.
+type InstanceGroupEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InstanceGroupEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InstanceGroupEventMultiError) AllErrors() []error { return m }
+
+// InstanceGroupEventValidationError is the validation error returned by
+// InstanceGroupEvent.Validate if the designated constraints aren't met.
+type InstanceGroupEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceGroupEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceGroupEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceGroupEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceGroupEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceGroupEventValidationError) ErrorName() string {
+	return "InstanceGroupEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InstanceGroupEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstanceGroupEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceGroupEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceGroupEventValidationError{}
+
+// Validate checks the field values on InstanceGroupCreatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InstanceGroupCreatedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceGroupCreatedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InstanceGroupCreatedEventMultiError, or nil if none found.
+func (m *InstanceGroupCreatedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceGroupCreatedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceGroupCreatedEventValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceGroupCreatedEventValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceGroupCreatedEventValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResources()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceGroupCreatedEventValidationError{
+					field:  "Resources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceGroupCreatedEventValidationError{
+					field:  "Resources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceGroupCreatedEventValidationError{
+				field:  "Resources",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InstanceGroupCreatedEventValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InstanceGroupCreatedEventValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InstanceGroupCreatedEventValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InstanceGroupCreatedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// InstanceGroupCreatedEventMultiError is an error wrapping multiple validation
+// errors returned by InstanceGroupCreatedEvent.ValidateAll() if the
+// designated constraints aren't met.
+type InstanceGroupCreatedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InstanceGroupCreatedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InstanceGroupCreatedEventMultiError) AllErrors() []error { return m }
+
+// InstanceGroupCreatedEventValidationError is the validation error returned by
+// InstanceGroupCreatedEvent.Validate if the designated constraints aren't met.
+type InstanceGroupCreatedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceGroupCreatedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceGroupCreatedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceGroupCreatedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceGroupCreatedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceGroupCreatedEventValidationError) ErrorName() string {
+	return "InstanceGroupCreatedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InstanceGroupCreatedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstanceGroupCreatedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceGroupCreatedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceGroupCreatedEventValidationError{}
+
+// Validate checks the field values on InstanceGroupMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InstanceGroupMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceGroupMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InstanceGroupMemberMultiError, or nil if none found.
+func (m *InstanceGroupMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceGroupMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInstance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceGroupMemberValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceGroupMemberValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceGroupMemberValidationError{
+				field:  "Instance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Hostname
+
+	if len(errors) > 0 {
+		return InstanceGroupMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// InstanceGroupMemberMultiError is an error wrapping multiple validation
+// errors returned by InstanceGroupMember.ValidateAll() if the designated
+// constraints aren't met.
+type InstanceGroupMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InstanceGroupMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InstanceGroupMemberMultiError) AllErrors() []error { return m }
+
+// InstanceGroupMemberValidationError is the validation error returned by
+// InstanceGroupMember.Validate if the designated constraints aren't met.
+type InstanceGroupMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceGroupMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceGroupMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceGroupMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceGroupMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceGroupMemberValidationError) ErrorName() string {
+	return "InstanceGroupMemberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InstanceGroupMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstanceGroupMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceGroupMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceGroupMemberValidationError{}
+
+// Validate checks the field values on InstanceGroupCreationFailedEvent with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InstanceGroupCreationFailedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceGroupCreationFailedEvent with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InstanceGroupCreationFailedEventMultiError, or nil if none found.
+func (m *InstanceGroupCreationFailedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceGroupCreationFailedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return InstanceGroupCreationFailedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// InstanceGroupCreationFailedEventMultiError is an error wrapping multiple
+// validation errors returned by
+// InstanceGroupCreationFailedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type InstanceGroupCreationFailedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InstanceGroupCreationFailedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InstanceGroupCreationFailedEventMultiError) AllErrors() []error { return m }
+
+// InstanceGroupCreationFailedEventValidationError is the validation error
+// returned by InstanceGroupCreationFailedEvent.Validate if the designated
+// constraints aren't met.
+type InstanceGroupCreationFailedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceGroupCreationFailedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceGroupCreationFailedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceGroupCreationFailedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceGroupCreationFailedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceGroupCreationFailedEventValidationError) ErrorName() string {
+	return "InstanceGroupCreationFailedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InstanceGroupCreationFailedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstanceGroupCreationFailedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceGroupCreationFailedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceGroupCreationFailedEventValidationError{}
@@ -514,6 +514,125 @@ func (m *CreateInstanceRequest) validate(all bool) error {
 
 	// no validation rules for PreferHpc
 
+	if len(m.GetLabels()) > 32 {
+		err := CreateInstanceRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 32 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := CreateInstanceRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_CreateInstanceRequest_Labels_Pattern.MatchString(key) {
+				err := CreateInstanceRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_-]*$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 63 {
+				err := CreateInstanceRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetMaxLifetime(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateInstanceRequestValidationError{
+				field:  "MaxLifetime",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CreateInstanceRequestValidationError{
+					field:  "MaxLifetime",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetIdleTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateInstanceRequestValidationError{
+				field:  "IdleTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CreateInstanceRequestValidationError{
+					field:  "IdleTimeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateInstanceRequestMultiError(errors)
 	}
@@ -602,6 +721,8 @@ var _ interface {
 	ErrorName() string
 } = CreateInstanceRequestValidationError{}
 
+var _CreateInstanceRequest_Labels_Pattern = regexp.MustCompile("^[a-z][a-z0-9_-]*$")
+
 // Validate checks the field values on CreateInstanceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -791,30 +912,53 @@ var _ interface {
 	ErrorName() string
 } = CreateInstanceResponseValidationError{}
 
-// Validate checks the field values on ListInstancesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateInstanceGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListInstancesRequest) Validate() error {
+func (m *CreateInstanceGroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListInstancesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateInstanceGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListInstancesRequestMultiError, or nil if none found.
-func (m *ListInstancesRequest) ValidateAll() error {
+// CreateInstanceGroupRequestMultiError, or nil if none found.
+func (m *CreateInstanceGroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListInstancesRequest) validate(all bool) error {
+func (m *CreateInstanceGroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = CreateInstanceGroupRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSize(); val < 1 || val > 64 {
+		err := CreateInstanceGroupRequestValidationError{
+			field:  "Size",
+			reason: "value must be inside range [1, 64]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if utf8.RuneCountInString(m.GetOwner()) < 1 {
-		err := ListInstancesRequestValidationError{
+		err := CreateInstanceGroupRequestValidationError{
 			field:  "Owner",
 			reason: "value length must be at least 1 runes",
 		}
@@ -824,20 +968,240 @@ func (m *ListInstancesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetComponent()) < 1 {
+		err := CreateInstanceGroupRequestValidationError{
+			field:  "Component",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetConfig() == nil {
+		err := CreateInstanceGroupRequestValidationError{
+			field:  "Config",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInstanceGroupRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInstanceGroupRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInstanceGroupRequestValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetResources() == nil {
+		err := CreateInstanceGroupRequestValidationError{
+			field:  "Resources",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResources()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInstanceGroupRequestValidationError{
+					field:  "Resources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInstanceGroupRequestValidationError{
+					field:  "Resources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInstanceGroupRequestValidationError{
+				field:  "Resources",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PreferHpc
+
+	if len(m.GetLabels()) > 32 {
+		err := CreateInstanceGroupRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 32 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := CreateInstanceGroupRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_CreateInstanceGroupRequest_Labels_Pattern.MatchString(key) {
+				err := CreateInstanceGroupRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value does not match regex pattern \"^[a-z][a-z0-9_-]*$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 63 {
+				err := CreateInstanceGroupRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetMaxLifetime(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateInstanceGroupRequestValidationError{
+				field:  "MaxLifetime",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CreateInstanceGroupRequestValidationError{
+					field:  "MaxLifetime",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetIdleTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateInstanceGroupRequestValidationError{
+				field:  "IdleTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CreateInstanceGroupRequestValidationError{
+					field:  "IdleTimeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListInstancesRequestMultiError(errors)
+		return CreateInstanceGroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListInstancesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListInstancesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListInstancesRequestMultiError []error
+func (m *CreateInstanceGroupRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateInstanceGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateInstanceGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateInstanceGroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListInstancesRequestMultiError) Error() string {
+func (m CreateInstanceGroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -846,11 +1210,11 @@ func (m ListInstancesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListInstancesRequestMultiError) AllErrors() []error { return m }
+func (m CreateInstanceGroupRequestMultiError) AllErrors() []error { return m }
 
-// ListInstancesRequestValidationError is the validation error returned by
-// ListInstancesRequest.Validate if the designated constraints aren't met.
-type ListInstancesRequestValidationError struct {
+// CreateInstanceGroupRequestValidationError is the validation error returned
+// by CreateInstanceGroupRequest.Validate if the designated constraints aren't met.
+type CreateInstanceGroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -858,24 +1222,24 @@ type ListInstancesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListInstancesRequestValidationError) Field() string { return e.field }
+func (e CreateInstanceGroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListInstancesRequestValidationError) Reason() string { return e.reason }
+func (e CreateInstanceGroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListInstancesRequestValidationError) Cause() error { return e.cause }
+func (e CreateInstanceGroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListInstancesRequestValidationError) Key() bool { return e.key }
+func (e CreateInstanceGroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListInstancesRequestValidationError) ErrorName() string {
-	return "ListInstancesRequestValidationError"
+func (e CreateInstanceGroupRequestValidationError) ErrorName() string {
+	return "CreateInstanceGroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListInstancesRequestValidationError) Error() string {
+func (e CreateInstanceGroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -887,14 +1251,14 @@ func (e ListInstancesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListInstancesRequest.%s: %s%s",
+		"invalid %sCreateInstanceGroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListInstancesRequestValidationError{}
+var _ error = CreateInstanceGroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -902,46 +1266,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListInstancesRequestValidationError{}
+} = CreateInstanceGroupRequestValidationError{}
 
-// Validate checks the field values on ListInstancesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+var _CreateInstanceGroupRequest_Labels_Pattern = regexp.MustCompile("^[a-z][a-z0-9_-]*$")
+
+// Validate checks the field values on CreateInstanceGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListInstancesResponse) Validate() error {
+func (m *CreateInstanceGroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListInstancesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateInstanceGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListInstancesResponseMultiError, or nil if none found.
-func (m *ListInstancesResponse) ValidateAll() error {
+// CreateInstanceGroupResponseMultiError, or nil if none found.
+func (m *CreateInstanceGroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListInstancesResponse) validate(all bool) error {
+func (m *CreateInstanceGroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetInstances() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListInstancesResponseValidationError{
-						field:  fmt.Sprintf("Instances[%v]", idx),
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInstanceGroupResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInstanceGroupResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInstanceGroupResponseValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetInstances() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateInstanceGroupResponseValidationError{
+						field:  fmt.Sprintf("Instances[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListInstancesResponseValidationError{
+					errors = append(errors, CreateInstanceGroupResponseValidationError{
 						field:  fmt.Sprintf("Instances[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -950,7 +1345,7 @@ func (m *ListInstancesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListInstancesResponseValidationError{
+				return CreateInstanceGroupResponseValidationError{
 					field:  fmt.Sprintf("Instances[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -960,20 +1355,1474 @@ func (m *ListInstancesResponse) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInstanceGroupResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInstanceGroupResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInstanceGroupResponseValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResources()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInstanceGroupResponseValidationError{
+					field:  "Resources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInstanceGroupResponseValidationError{
+					field:  "Resources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInstanceGroupResponseValidationError{
+				field:  "Resources",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateInstanceGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInstanceGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateInstanceGroupResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateInstanceGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInstanceGroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInstanceGroupResponseMultiError) AllErrors() []error { return m }
+
+// CreateInstanceGroupResponseValidationError is the validation error returned
+// by CreateInstanceGroupResponse.Validate if the designated constraints
+// aren't met.
+type CreateInstanceGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInstanceGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInstanceGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInstanceGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInstanceGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInstanceGroupResponseValidationError) ErrorName() string {
+	return "CreateInstanceGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInstanceGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInstanceGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInstanceGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInstanceGroupResponseValidationError{}
+
+// Validate checks the field values on ListInstancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInstancesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInstancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInstancesRequestMultiError, or nil if none found.
+func (m *ListInstancesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInstancesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOwner()) < 1 {
+		err := ListInstancesRequestValidationError{
+			field:  "Owner",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for LabelSelector
+
+	if m.GetPageSize() > 1000 {
+		err := ListInstancesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for Component
+
+	if _, ok := CloudProvider_name[int32(m.GetCloudProvider())]; !ok {
+		err := ListInstancesRequestValidationError{
+			field:  "CloudProvider",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Zone
+
+	if _, ok := GPUKind_name[int32(m.GetGpuKind())]; !ok {
+		err := ListInstancesRequestValidationError{
+			field:  "GpuKind",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.IsSpot != nil {
+		// no validation rules for IsSpot
+	}
+
+	if len(errors) > 0 {
+		return ListInstancesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInstancesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInstancesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInstancesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInstancesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInstancesRequestMultiError) AllErrors() []error { return m }
+
+// ListInstancesRequestValidationError is the validation error returned by
+// ListInstancesRequest.Validate if the designated constraints aren't met.
+type ListInstancesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInstancesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInstancesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInstancesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInstancesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInstancesRequestValidationError) ErrorName() string {
+	return "ListInstancesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInstancesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInstancesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInstancesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInstancesRequestValidationError{}
+
+// Validate checks the field values on ListInstancesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInstancesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInstancesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInstancesResponseMultiError, or nil if none found.
+func (m *ListInstancesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInstancesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInstances() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInstancesResponseValidationError{
+						field:  fmt.Sprintf("Instances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInstancesResponseValidationError{
+						field:  fmt.Sprintf("Instances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInstancesResponseValidationError{
+					field:  fmt.Sprintf("Instances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListInstancesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInstancesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInstancesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListInstancesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInstancesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInstancesResponseMultiError) AllErrors() []error { return m }
+
+// ListInstancesResponseValidationError is the validation error returned by
+// ListInstancesResponse.Validate if the designated constraints aren't met.
+type ListInstancesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInstancesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInstancesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInstancesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInstancesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInstancesResponseValidationError) ErrorName() string {
+	return "ListInstancesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInstancesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInstancesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInstancesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInstancesResponseValidationError{}
+
+// Validate checks the field values on RunningInstance with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RunningInstance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RunningInstance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RunningInstanceMultiError, or nil if none found.
+func (m *RunningInstance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RunningInstance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInstance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunningInstanceValidationError{
+				field:  "Instance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Component
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunningInstanceValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResources()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Resources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Resources",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunningInstanceValidationError{
+				field:  "Resources",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Hostname
+
+	if all {
+		switch v := interface{}(m.GetLastSeen()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "LastSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "LastSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeen()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunningInstanceValidationError{
+				field:  "LastSeen",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUtilization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Utilization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Utilization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUtilization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunningInstanceValidationError{
+				field:  "Utilization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunningInstanceValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunningInstanceValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RunningInstanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunningInstanceMultiError is an error wrapping multiple validation errors
+// returned by RunningInstance.ValidateAll() if the designated constraints
+// aren't met.
+type RunningInstanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunningInstanceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunningInstanceMultiError) AllErrors() []error { return m }
+
+// RunningInstanceValidationError is the validation error returned by
+// RunningInstance.Validate if the designated constraints aren't met.
+type RunningInstanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunningInstanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunningInstanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunningInstanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunningInstanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunningInstanceValidationError) ErrorName() string { return "RunningInstanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunningInstanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunningInstance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunningInstanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunningInstanceValidationError{}
+
+// Validate checks the field values on ShutdownInstanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShutdownInstanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShutdownInstanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShutdownInstanceRequestMultiError, or nil if none found.
+func (m *ShutdownInstanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShutdownInstanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetInstance() == nil {
+		err := ShutdownInstanceRequestValidationError{
+			field:  "Instance",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInstance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShutdownInstanceRequestValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShutdownInstanceRequestValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShutdownInstanceRequestValidationError{
+				field:  "Instance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShutdownInstanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShutdownInstanceRequestMultiError is an error wrapping multiple validation
+// errors returned by ShutdownInstanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ShutdownInstanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShutdownInstanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShutdownInstanceRequestMultiError) AllErrors() []error { return m }
+
+// ShutdownInstanceRequestValidationError is the validation error returned by
+// ShutdownInstanceRequest.Validate if the designated constraints aren't met.
+type ShutdownInstanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShutdownInstanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShutdownInstanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShutdownInstanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShutdownInstanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShutdownInstanceRequestValidationError) ErrorName() string {
+	return "ShutdownInstanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShutdownInstanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShutdownInstanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShutdownInstanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShutdownInstanceRequestValidationError{}
+
+// Validate checks the field values on ShutdownInstanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShutdownInstanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShutdownInstanceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShutdownInstanceResponseMultiError, or nil if none found.
+func (m *ShutdownInstanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShutdownInstanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ShutdownInstanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShutdownInstanceResponseMultiError is an error wrapping multiple validation
+// errors returned by ShutdownInstanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ShutdownInstanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShutdownInstanceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShutdownInstanceResponseMultiError) AllErrors() []error { return m }
+
+// ShutdownInstanceResponseValidationError is the validation error returned by
+// ShutdownInstanceResponse.Validate if the designated constraints aren't met.
+type ShutdownInstanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShutdownInstanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShutdownInstanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShutdownInstanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShutdownInstanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShutdownInstanceResponseValidationError) ErrorName() string {
+	return "ShutdownInstanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShutdownInstanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShutdownInstanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShutdownInstanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShutdownInstanceResponseValidationError{}
+
+// Validate checks the field values on WatchInstancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchInstancesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchInstancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchInstancesRequestMultiError, or nil if none found.
+func (m *WatchInstancesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchInstancesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOwner()) < 1 {
+		err := WatchInstancesRequestValidationError{
+			field:  "Owner",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchInstancesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchInstancesRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchInstancesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchInstancesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchInstancesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchInstancesRequestMultiError) AllErrors() []error { return m }
+
+// WatchInstancesRequestValidationError is the validation error returned by
+// WatchInstancesRequest.Validate if the designated constraints aren't met.
+type WatchInstancesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchInstancesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchInstancesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchInstancesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchInstancesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchInstancesRequestValidationError) ErrorName() string {
+	return "WatchInstancesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchInstancesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchInstancesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchInstancesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchInstancesRequestValidationError{}
+
+// Validate checks the field values on WatchInstancesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchInstancesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchInstancesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchInstancesResponseMultiError, or nil if none found.
+func (m *WatchInstancesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchInstancesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Update.(type) {
+	case *WatchInstancesResponse_Running:
+		if v == nil {
+			err := WatchInstancesResponseValidationError{
+				field:  "Update",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRunning()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchInstancesResponseValidationError{
+						field:  "Running",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchInstancesResponseValidationError{
+						field:  "Running",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRunning()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchInstancesResponseValidationError{
+					field:  "Running",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *WatchInstancesResponse_Event:
+		if v == nil {
+			err := WatchInstancesResponseValidationError{
+				field:  "Update",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEvent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchInstancesResponseValidationError{
+						field:  "Event",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchInstancesResponseValidationError{
+						field:  "Event",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchInstancesResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return WatchInstancesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchInstancesResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchInstancesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchInstancesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchInstancesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchInstancesResponseMultiError) AllErrors() []error { return m }
+
+// WatchInstancesResponseValidationError is the validation error returned by
+// WatchInstancesResponse.Validate if the designated constraints aren't met.
+type WatchInstancesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchInstancesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchInstancesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchInstancesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchInstancesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchInstancesResponseValidationError) ErrorName() string {
+	return "WatchInstancesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchInstancesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchInstancesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchInstancesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchInstancesResponseValidationError{}
+
+// Validate checks the field values on InstanceHeartbeatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InstanceHeartbeatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceHeartbeatRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InstanceHeartbeatRequestMultiError, or nil if none found.
+func (m *InstanceHeartbeatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceHeartbeatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetInstance() == nil {
+		err := InstanceHeartbeatRequestValidationError{
+			field:  "Instance",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInstance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceHeartbeatRequestValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceHeartbeatRequestValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceHeartbeatRequestValidationError{
+				field:  "Instance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUtilization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InstanceHeartbeatRequestValidationError{
+					field:  "Utilization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InstanceHeartbeatRequestValidationError{
+					field:  "Utilization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUtilization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InstanceHeartbeatRequestValidationError{
+				field:  "Utilization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InstanceHeartbeatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InstanceHeartbeatRequestMultiError is an error wrapping multiple validation
+// errors returned by InstanceHeartbeatRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InstanceHeartbeatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InstanceHeartbeatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InstanceHeartbeatRequestMultiError) AllErrors() []error { return m }
+
+// InstanceHeartbeatRequestValidationError is the validation error returned by
+// InstanceHeartbeatRequest.Validate if the designated constraints aren't met.
+type InstanceHeartbeatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceHeartbeatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceHeartbeatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceHeartbeatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceHeartbeatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceHeartbeatRequestValidationError) ErrorName() string {
+	return "InstanceHeartbeatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InstanceHeartbeatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstanceHeartbeatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceHeartbeatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceHeartbeatRequestValidationError{}
+
+// Validate checks the field values on InstanceHeartbeatResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InstanceHeartbeatResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceHeartbeatResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InstanceHeartbeatResponseMultiError, or nil if none found.
+func (m *InstanceHeartbeatResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceHeartbeatResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if len(errors) > 0 {
-		return ListInstancesResponseMultiError(errors)
+		return InstanceHeartbeatResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListInstancesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListInstancesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListInstancesResponseMultiError []error
+// InstanceHeartbeatResponseMultiError is an error wrapping multiple validation
+// errors returned by InstanceHeartbeatResponse.ValidateAll() if the
+// designated constraints aren't met.
+type InstanceHeartbeatResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListInstancesResponseMultiError) Error() string {
+func (m InstanceHeartbeatResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -982,11 +2831,11 @@ func (m ListInstancesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListInstancesResponseMultiError) AllErrors() []error { return m }
+func (m InstanceHeartbeatResponseMultiError) AllErrors() []error { return m }
 
-// ListInstancesResponseValidationError is the validation error returned by
-// ListInstancesResponse.Validate if the designated constraints aren't met.
-type ListInstancesResponseValidationError struct {
+// InstanceHeartbeatResponseValidationError is the validation error returned by
+// InstanceHeartbeatResponse.Validate if the designated constraints aren't met.
+type InstanceHeartbeatResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -994,24 +2843,24 @@ type ListInstancesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListInstancesResponseValidationError) Field() string { return e.field }
+func (e InstanceHeartbeatResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListInstancesResponseValidationError) Reason() string { return e.reason }
+func (e InstanceHeartbeatResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListInstancesResponseValidationError) Cause() error { return e.cause }
+func (e InstanceHeartbeatResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListInstancesResponseValidationError) Key() bool { return e.key }
+func (e InstanceHeartbeatResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListInstancesResponseValidationError) ErrorName() string {
-	return "ListInstancesResponseValidationError"
+func (e InstanceHeartbeatResponseValidationError) ErrorName() string {
+	return "InstanceHeartbeatResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListInstancesResponseValidationError) Error() string {
+func (e InstanceHeartbeatResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1023,14 +2872,14 @@ func (e ListInstancesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListInstancesResponse.%s: %s%s",
+		"invalid %sInstanceHeartbeatResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListInstancesResponseValidationError{}
+var _ error = InstanceHeartbeatResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1038,135 +2887,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListInstancesResponseValidationError{}
+} = InstanceHeartbeatResponseValidationError{}
 
-// Validate checks the field values on RunningInstance with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *RunningInstance) Validate() error {
+// Validate checks the field values on GetCostReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCostReportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RunningInstance with the rules
+// ValidateAll checks the field values on GetCostReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RunningInstanceMultiError, or nil if none found.
-func (m *RunningInstance) ValidateAll() error {
+// GetCostReportRequestMultiError, or nil if none found.
+func (m *GetCostReportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RunningInstance) validate(all bool) error {
+func (m *GetCostReportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetInstance()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RunningInstanceValidationError{
-					field:  "Instance",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RunningInstanceValidationError{
-					field:  "Instance",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetStartTime() == nil {
+		err := GetCostReportRequestValidationError{
+			field:  "StartTime",
+			reason: "value is required",
 		}
-	} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RunningInstanceValidationError{
-				field:  "Instance",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Component
-
 	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
+		switch v := interface{}(m.GetEndTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RunningInstanceValidationError{
-					field:  "Config",
+				errors = append(errors, GetCostReportRequestValidationError{
+					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RunningInstanceValidationError{
-					field:  "Config",
+				errors = append(errors, GetCostReportRequestValidationError{
+					field:  "EndTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RunningInstanceValidationError{
-				field:  "Config",
+			return GetCostReportRequestValidationError{
+				field:  "EndTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetResources()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RunningInstanceValidationError{
-					field:  "Resources",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RunningInstanceValidationError{
-					field:  "Resources",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResources()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RunningInstanceValidationError{
-				field:  "Resources",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Owner
 
-	// no validation rules for Hostname
+	// no validation rules for Component
 
 	if len(errors) > 0 {
-		return RunningInstanceMultiError(errors)
+		return GetCostReportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RunningInstanceMultiError is an error wrapping multiple validation errors
-// returned by RunningInstance.ValidateAll() if the designated constraints
-// aren't met.
-type RunningInstanceMultiError []error
+// GetCostReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCostReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCostReportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RunningInstanceMultiError) Error() string {
+func (m GetCostReportRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1175,11 +2977,11 @@ func (m RunningInstanceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RunningInstanceMultiError) AllErrors() []error { return m }
+func (m GetCostReportRequestMultiError) AllErrors() []error { return m }
 
-// RunningInstanceValidationError is the validation error returned by
-// RunningInstance.Validate if the designated constraints aren't met.
-type RunningInstanceValidationError struct {
+// GetCostReportRequestValidationError is the validation error returned by
+// GetCostReportRequest.Validate if the designated constraints aren't met.
+type GetCostReportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1187,22 +2989,24 @@ type RunningInstanceValidationError struct {
 }
 
 // Field function returns field value.
-func (e RunningInstanceValidationError) Field() string { return e.field }
+func (e GetCostReportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RunningInstanceValidationError) Reason() string { return e.reason }
+func (e GetCostReportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RunningInstanceValidationError) Cause() error { return e.cause }
+func (e GetCostReportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RunningInstanceValidationError) Key() bool { return e.key }
+func (e GetCostReportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RunningInstanceValidationError) ErrorName() string { return "RunningInstanceValidationError" }
+func (e GetCostReportRequestValidationError) ErrorName() string {
+	return "GetCostReportRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RunningInstanceValidationError) Error() string {
+func (e GetCostReportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1214,14 +3018,14 @@ func (e RunningInstanceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRunningInstance.%s: %s%s",
+		"invalid %sGetCostReportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RunningInstanceValidationError{}
+var _ error = GetCostReportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1229,84 +3033,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RunningInstanceValidationError{}
+} = GetCostReportRequestValidationError{}
 
-// Validate checks the field values on ShutdownInstanceRequest with the rules
+// Validate checks the field values on GetCostReportResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ShutdownInstanceRequest) Validate() error {
+func (m *GetCostReportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ShutdownInstanceRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetCostReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ShutdownInstanceRequestMultiError, or nil if none found.
-func (m *ShutdownInstanceRequest) ValidateAll() error {
+// GetCostReportResponseMultiError, or nil if none found.
+func (m *GetCostReportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ShutdownInstanceRequest) validate(all bool) error {
+func (m *GetCostReportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetInstance() == nil {
-		err := ShutdownInstanceRequestValidationError{
-			field:  "Instance",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetInstance()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ShutdownInstanceRequestValidationError{
-					field:  "Instance",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCostReportResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCostReportResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ShutdownInstanceRequestValidationError{
-					field:  "Instance",
+				return GetCostReportResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ShutdownInstanceRequestValidationError{
-				field:  "Instance",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalCost
+
 	if len(errors) > 0 {
-		return ShutdownInstanceRequestMultiError(errors)
+		return GetCostReportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ShutdownInstanceRequestMultiError is an error wrapping multiple validation
-// errors returned by ShutdownInstanceRequest.ValidateAll() if the designated
+// GetCostReportResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCostReportResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ShutdownInstanceRequestMultiError []error
+type GetCostReportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ShutdownInstanceRequestMultiError) Error() string {
+func (m GetCostReportResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1315,11 +3115,11 @@ func (m ShutdownInstanceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ShutdownInstanceRequestMultiError) AllErrors() []error { return m }
+func (m GetCostReportResponseMultiError) AllErrors() []error { return m }
 
-// ShutdownInstanceRequestValidationError is the validation error returned by
-// ShutdownInstanceRequest.Validate if the designated constraints aren't met.
-type ShutdownInstanceRequestValidationError struct {
+// GetCostReportResponseValidationError is the validation error returned by
+// GetCostReportResponse.Validate if the designated constraints aren't met.
+type GetCostReportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1327,24 +3127,24 @@ type ShutdownInstanceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ShutdownInstanceRequestValidationError) Field() string { return e.field }
+func (e GetCostReportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ShutdownInstanceRequestValidationError) Reason() string { return e.reason }
+func (e GetCostReportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ShutdownInstanceRequestValidationError) Cause() error { return e.cause }
+func (e GetCostReportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ShutdownInstanceRequestValidationError) Key() bool { return e.key }
+func (e GetCostReportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ShutdownInstanceRequestValidationError) ErrorName() string {
-	return "ShutdownInstanceRequestValidationError"
+func (e GetCostReportResponseValidationError) ErrorName() string {
+	return "GetCostReportResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ShutdownInstanceRequestValidationError) Error() string {
+func (e GetCostReportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1356,14 +3156,14 @@ func (e ShutdownInstanceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sShutdownInstanceRequest.%s: %s%s",
+		"invalid %sGetCostReportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ShutdownInstanceRequestValidationError{}
+var _ error = GetCostReportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1371,44 +3171,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ShutdownInstanceRequestValidationError{}
+} = GetCostReportResponseValidationError{}
 
-// Validate checks the field values on ShutdownInstanceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ShutdownInstanceResponse) Validate() error {
+// Validate checks the field values on CostReportEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CostReportEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ShutdownInstanceResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CostReportEntry with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ShutdownInstanceResponseMultiError, or nil if none found.
-func (m *ShutdownInstanceResponse) ValidateAll() error {
+// CostReportEntryMultiError, or nil if none found.
+func (m *CostReportEntry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ShutdownInstanceResponse) validate(all bool) error {
+func (m *CostReportEntry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Owner
+
+	// no validation rules for Component
+
+	// no validation rules for Cost
+
+	// no validation rules for InstanceHours
+
+	// no validation rules for UnpricedInstanceHours
+
 	if len(errors) > 0 {
-		return ShutdownInstanceResponseMultiError(errors)
+		return CostReportEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// ShutdownInstanceResponseMultiError is an error wrapping multiple validation
-// errors returned by ShutdownInstanceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ShutdownInstanceResponseMultiError []error
+// CostReportEntryMultiError is an error wrapping multiple validation errors
+// returned by CostReportEntry.ValidateAll() if the designated constraints
+// aren't met.
+type CostReportEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ShutdownInstanceResponseMultiError) Error() string {
+func (m CostReportEntryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1417,11 +3227,11 @@ func (m ShutdownInstanceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ShutdownInstanceResponseMultiError) AllErrors() []error { return m }
+func (m CostReportEntryMultiError) AllErrors() []error { return m }
 
-// ShutdownInstanceResponseValidationError is the validation error returned by
-// ShutdownInstanceResponse.Validate if the designated constraints aren't met.
-type ShutdownInstanceResponseValidationError struct {
+// CostReportEntryValidationError is the validation error returned by
+// CostReportEntry.Validate if the designated constraints aren't met.
+type CostReportEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1429,24 +3239,22 @@ type ShutdownInstanceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ShutdownInstanceResponseValidationError) Field() string { return e.field }
+func (e CostReportEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ShutdownInstanceResponseValidationError) Reason() string { return e.reason }
+func (e CostReportEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ShutdownInstanceResponseValidationError) Cause() error { return e.cause }
+func (e CostReportEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ShutdownInstanceResponseValidationError) Key() bool { return e.key }
+func (e CostReportEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ShutdownInstanceResponseValidationError) ErrorName() string {
-	return "ShutdownInstanceResponseValidationError"
-}
+func (e CostReportEntryValidationError) ErrorName() string { return "CostReportEntryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ShutdownInstanceResponseValidationError) Error() string {
+func (e CostReportEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1458,14 +3266,14 @@ func (e ShutdownInstanceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sShutdownInstanceResponse.%s: %s%s",
+		"invalid %sCostReportEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ShutdownInstanceResponseValidationError{}
+var _ error = CostReportEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -1473,4 +3281,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ShutdownInstanceResponseValidationError{}
+} = CostReportEntryValidationError{}
@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GenesisService_ListZones_FullMethodName        = "/genesis.v1.GenesisService/ListZones"
-	GenesisService_CreateInstance_FullMethodName   = "/genesis.v1.GenesisService/CreateInstance"
-	GenesisService_ListInstances_FullMethodName    = "/genesis.v1.GenesisService/ListInstances"
-	GenesisService_ShutdownInstance_FullMethodName = "/genesis.v1.GenesisService/ShutdownInstance"
+	GenesisService_ListZones_FullMethodName           = "/genesis.v1.GenesisService/ListZones"
+	GenesisService_CreateInstance_FullMethodName      = "/genesis.v1.GenesisService/CreateInstance"
+	GenesisService_CreateInstanceGroup_FullMethodName = "/genesis.v1.GenesisService/CreateInstanceGroup"
+	GenesisService_ListInstances_FullMethodName       = "/genesis.v1.GenesisService/ListInstances"
+	GenesisService_ShutdownInstance_FullMethodName    = "/genesis.v1.GenesisService/ShutdownInstance"
+	GenesisService_WatchInstances_FullMethodName      = "/genesis.v1.GenesisService/WatchInstances"
+	GenesisService_InstanceHeartbeat_FullMethodName   = "/genesis.v1.GenesisService/InstanceHeartbeat"
+	GenesisService_GetCostReport_FullMethodName       = "/genesis.v1.GenesisService/GetCostReport"
 )
 
 // GenesisServiceClient is the client API for GenesisService service.
@@ -36,14 +40,48 @@ type GenesisServiceClient interface {
 	// CreateInstance creates a new instance according to the given requirements. If the creation
 	// is successful, the instance configuration will be returned. The full instance status
 	// (including its IP) will be delivered via Kafka as soon as the instance is up and running.
+	//
+	// The call is idempotent: retrying a request with the same ID, owner and specification returns
+	// the response of the original request without creating another instance. If the instance ID
+	// was already used with a different owner or specification, `ALREADY_EXISTS` is returned. If
+	// the creation of the instance already failed, `FAILED_PRECONDITION` is returned along with the
+	// `genesis.messages.v1.InstanceCreationFailedEvent` describing the failure as error detail.
 	CreateInstance(ctx context.Context, in *CreateInstanceRequest, opts ...grpc.CallOption) (*CreateInstanceResponse, error)
+	// CreateInstanceGroup creates a group of identical instances in the same zone, e.g. for
+	// distributed training. The group is created atomically: either all instances are created or,
+	// if the creation of any instance fails, all instances of the group that were already created
+	// are deleted again. Like `CreateInstance`, the call returns as soon as the group was accepted
+	// and the outcome is delivered via Kafka as soon as all instances are up and running. Besides
+	// events for the individual instances, an `InstanceGroupEvent` is published for the group.
+	//
+	// The call is idempotent with the group ID serving as idempotency key. The IDs of the group's
+	// instances are derived from the group ID.
+	CreateInstanceGroup(ctx context.Context, in *CreateInstanceGroupRequest, opts ...grpc.CallOption) (*CreateInstanceGroupResponse, error)
 	// ListInstances returns all the instances that are owned by a particular owner and which are
 	// running at the moment. In particular, the returned set of instances does not include
-	// instances which were requested successfully but are not running yet.
+	// instances which were requested successfully but are not running yet. Instances are returned
+	// in pages and may optionally be filtered.
 	ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error)
 	// ShutdownInstance shuts down the instance described by the request. It does not return
 	// anything if deletion was successful.
 	ShutdownInstance(ctx context.Context, in *ShutdownInstanceRequest, opts ...grpc.CallOption) (*ShutdownInstanceResponse, error)
+	// WatchInstances streams the state of all instances owned by a particular owner. Upon
+	// connection, all instances which are currently running are sent. Afterwards, lifecycle events
+	// of the owner's instances are streamed as they occur, i.e. the stream delivers the same events
+	// that are delivered via Kafka. Instances that are created while the watch is being set up might
+	// be delivered both as running instance and via an event.
+	WatchInstances(ctx context.Context, in *WatchInstancesRequest, opts ...grpc.CallOption) (GenesisService_WatchInstancesClient, error)
+	// InstanceHeartbeat reports that an instance is alive. It should be called periodically by an
+	// agent running on the instance, optionally reporting the instance's current utilization.
+	// Instances which miss heartbeats may be deemed unhealthy and terminated. If the instance does
+	// not exist (anymore), `NOT_FOUND` is returned and the agent should stop sending heartbeats.
+	InstanceHeartbeat(ctx context.Context, in *InstanceHeartbeatRequest, opts ...grpc.CallOption) (*InstanceHeartbeatResponse, error)
+	// GetCostReport returns the cost accrued by instances within a time window, aggregated per
+	// owner and component. An instance accrues cost from the time at which it was requested until
+	// the time at which it was deleted. Costs are estimated from the prices in Genesis' catalog of
+	// instance types, i.e. they do not necessarily coincide with the costs billed by the cloud
+	// providers. Instances whose price is unknown are reported separately.
+	GetCostReport(ctx context.Context, in *GetCostReportRequest, opts ...grpc.CallOption) (*GetCostReportResponse, error)
 }
 
 type genesisServiceClient struct {
@@ -72,6 +110,15 @@ func (c *genesisServiceClient) CreateInstance(ctx context.Context, in *CreateIns
 	return out, nil
 }
 
+func (c *genesisServiceClient) CreateInstanceGroup(ctx context.Context, in *CreateInstanceGroupRequest, opts ...grpc.CallOption) (*CreateInstanceGroupResponse, error) {
+	out := new(CreateInstanceGroupResponse)
+	err := c.cc.Invoke(ctx, GenesisService_CreateInstanceGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *genesisServiceClient) ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error) {
 	out := new(ListInstancesResponse)
 	err := c.cc.Invoke(ctx, GenesisService_ListInstances_FullMethodName, in, out, opts...)
@@ -90,6 +137,56 @@ func (c *genesisServiceClient) ShutdownInstance(ctx context.Context, in *Shutdow
 	return out, nil
 }
 
+func (c *genesisServiceClient) WatchInstances(ctx context.Context, in *WatchInstancesRequest, opts ...grpc.CallOption) (GenesisService_WatchInstancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GenesisService_ServiceDesc.Streams[0], GenesisService_WatchInstances_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &genesisServiceWatchInstancesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GenesisService_WatchInstancesClient interface {
+	Recv() (*WatchInstancesResponse, error)
+	grpc.ClientStream
+}
+
+type genesisServiceWatchInstancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *genesisServiceWatchInstancesClient) Recv() (*WatchInstancesResponse, error) {
+	m := new(WatchInstancesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *genesisServiceClient) InstanceHeartbeat(ctx context.Context, in *InstanceHeartbeatRequest, opts ...grpc.CallOption) (*InstanceHeartbeatResponse, error) {
+	out := new(InstanceHeartbeatResponse)
+	err := c.cc.Invoke(ctx, GenesisService_InstanceHeartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genesisServiceClient) GetCostReport(ctx context.Context, in *GetCostReportRequest, opts ...grpc.CallOption) (*GetCostReportResponse, error) {
+	out := new(GetCostReportResponse)
+	err := c.cc.Invoke(ctx, GenesisService_GetCostReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GenesisServiceServer is the server API for GenesisService service.
 // All implementations must embed UnimplementedGenesisServiceServer
 // for forward compatibility
@@ -101,14 +198,48 @@ type GenesisServiceServer interface {
 	// CreateInstance creates a new instance according to the given requirements. If the creation
 	// is successful, the instance configuration will be returned. The full instance status
 	// (including its IP) will be delivered via Kafka as soon as the instance is up and running.
+	//
+	// The call is idempotent: retrying a request with the same ID, owner and specification returns
+	// the response of the original request without creating another instance. If the instance ID
+	// was already used with a different owner or specification, `ALREADY_EXISTS` is returned. If
+	// the creation of the instance already failed, `FAILED_PRECONDITION` is returned along with the
+	// `genesis.messages.v1.InstanceCreationFailedEvent` describing the failure as error detail.
 	CreateInstance(context.Context, *CreateInstanceRequest) (*CreateInstanceResponse, error)
+	// CreateInstanceGroup creates a group of identical instances in the same zone, e.g. for
+	// distributed training. The group is created atomically: either all instances are created or,
+	// if the creation of any instance fails, all instances of the group that were already created
+	// are deleted again. Like `CreateInstance`, the call returns as soon as the group was accepted
+	// and the outcome is delivered via Kafka as soon as all instances are up and running. Besides
+	// events for the individual instances, an `InstanceGroupEvent` is published for the group.
+	//
+	// The call is idempotent with the group ID serving as idempotency key. The IDs of the group's
+	// instances are derived from the group ID.
+	CreateInstanceGroup(context.Context, *CreateInstanceGroupRequest) (*CreateInstanceGroupResponse, error)
 	// ListInstances returns all the instances that are owned by a particular owner and which are
 	// running at the moment. In particular, the returned set of instances does not include
-	// instances which were requested successfully but are not running yet.
+	// instances which were requested successfully but are not running yet. Instances are returned
+	// in pages and may optionally be filtered.
 	ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error)
 	// ShutdownInstance shuts down the instance described by the request. It does not return
 	// anything if deletion was successful.
 	ShutdownInstance(context.Context, *ShutdownInstanceRequest) (*ShutdownInstanceResponse, error)
+	// WatchInstances streams the state of all instances owned by a particular owner. Upon
+	// connection, all instances which are currently running are sent. Afterwards, lifecycle events
+	// of the owner's instances are streamed as they occur, i.e. the stream delivers the same events
+	// that are delivered via Kafka. Instances that are created while the watch is being set up might
+	// be delivered both as running instance and via an event.
+	WatchInstances(*WatchInstancesRequest, GenesisService_WatchInstancesServer) error
+	// InstanceHeartbeat reports that an instance is alive. It should be called periodically by an
+	// agent running on the instance, optionally reporting the instance's current utilization.
+	// Instances which miss heartbeats may be deemed unhealthy and terminated. If the instance does
+	// not exist (anymore), `NOT_FOUND` is returned and the agent should stop sending heartbeats.
+	InstanceHeartbeat(context.Context, *InstanceHeartbeatRequest) (*InstanceHeartbeatResponse, error)
+	// GetCostReport returns the cost accrued by instances within a time window, aggregated per
+	// owner and component. An instance accrues cost from the time at which it was requested until
+	// the time at which it was deleted. Costs are estimated from the prices in Genesis' catalog of
+	// instance types, i.e. they do not necessarily coincide with the costs billed by the cloud
+	// providers. Instances whose price is unknown are reported separately.
+	GetCostReport(context.Context, *GetCostReportRequest) (*GetCostReportResponse, error)
 	mustEmbedUnimplementedGenesisServiceServer()
 }
 
@@ -122,12 +253,24 @@ func (UnimplementedGenesisServiceServer) ListZones(context.Context, *ListZonesRe
 func (UnimplementedGenesisServiceServer) CreateInstance(context.Context, *CreateInstanceRequest) (*CreateInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstance not implemented")
 }
+func (UnimplementedGenesisServiceServer) CreateInstanceGroup(context.Context, *CreateInstanceGroupRequest) (*CreateInstanceGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInstanceGroup not implemented")
+}
 func (UnimplementedGenesisServiceServer) ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
 }
 func (UnimplementedGenesisServiceServer) ShutdownInstance(context.Context, *ShutdownInstanceRequest) (*ShutdownInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShutdownInstance not implemented")
 }
+func (UnimplementedGenesisServiceServer) WatchInstances(*WatchInstancesRequest, GenesisService_WatchInstancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInstances not implemented")
+}
+func (UnimplementedGenesisServiceServer) InstanceHeartbeat(context.Context, *InstanceHeartbeatRequest) (*InstanceHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceHeartbeat not implemented")
+}
+func (UnimplementedGenesisServiceServer) GetCostReport(context.Context, *GetCostReportRequest) (*GetCostReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCostReport not implemented")
+}
 func (UnimplementedGenesisServiceServer) mustEmbedUnimplementedGenesisServiceServer() {}
 
 // UnsafeGenesisServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -177,6 +320,24 @@ func _GenesisService_CreateInstance_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GenesisService_CreateInstanceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstanceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenesisServiceServer).CreateInstanceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenesisService_CreateInstanceGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenesisServiceServer).CreateInstanceGroup(ctx, req.(*CreateInstanceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GenesisService_ListInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListInstancesRequest)
 	if err := dec(in); err != nil {
@@ -213,6 +374,63 @@ func _GenesisService_ShutdownInstance_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GenesisService_WatchInstances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInstancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GenesisServiceServer).WatchInstances(m, &genesisServiceWatchInstancesServer{stream})
+}
+
+type GenesisService_WatchInstancesServer interface {
+	Send(*WatchInstancesResponse) error
+	grpc.ServerStream
+}
+
+type genesisServiceWatchInstancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *genesisServiceWatchInstancesServer) Send(m *WatchInstancesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GenesisService_InstanceHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenesisServiceServer).InstanceHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenesisService_InstanceHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenesisServiceServer).InstanceHeartbeat(ctx, req.(*InstanceHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenesisService_GetCostReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCostReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenesisServiceServer).GetCostReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenesisService_GetCostReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenesisServiceServer).GetCostReport(ctx, req.(*GetCostReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GenesisService_ServiceDesc is the grpc.ServiceDesc for GenesisService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -228,6 +446,10 @@ var GenesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateInstance",
 			Handler:    _GenesisService_CreateInstance_Handler,
 		},
+		{
+			MethodName: "CreateInstanceGroup",
+			Handler:    _GenesisService_CreateInstanceGroup_Handler,
+		},
 		{
 			MethodName: "ListInstances",
 			Handler:    _GenesisService_ListInstances_Handler,
@@ -236,7 +458,21 @@ var GenesisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShutdownInstance",
 			Handler:    _GenesisService_ShutdownInstance_Handler,
 		},
+		{
+			MethodName: "InstanceHeartbeat",
+			Handler:    _GenesisService_InstanceHeartbeat_Handler,
+		},
+		{
+			MethodName: "GetCostReport",
+			Handler:    _GenesisService_GetCostReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInstances",
+			Handler:       _GenesisService_WatchInstances_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "genesis/v1/service.proto",
 }
@@ -188,6 +188,56 @@ func (x *Instance) GetId() string {
 	return ""
 }
 
+// InstanceGroup encapsulates the data required to refer to a group of VMs which were created
+// together.
+type InstanceGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The globally unique identifier of the group.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *InstanceGroup) Reset() {
+	*x = InstanceGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_genesis_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceGroup) ProtoMessage() {}
+
+func (x *InstanceGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_genesis_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceGroup.ProtoReflect.Descriptor instead.
+func (*InstanceGroup) Descriptor() ([]byte, []int) {
+	return file_genesis_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InstanceGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 // InstanceConfig describes core metadata about an instance, including its topology.
 type InstanceConfig struct {
 	state         protoimpl.MessageState
@@ -205,7 +255,7 @@ type InstanceConfig struct {
 func (x *InstanceConfig) Reset() {
 	*x = InstanceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_genesis_v1_types_proto_msgTypes[1]
+		mi := &file_genesis_v1_types_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -218,7 +268,7 @@ func (x *InstanceConfig) String() string {
 func (*InstanceConfig) ProtoMessage() {}
 
 func (x *InstanceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_genesis_v1_types_proto_msgTypes[1]
+	mi := &file_genesis_v1_types_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +281,7 @@ func (x *InstanceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceConfig.ProtoReflect.Descriptor instead.
 func (*InstanceConfig) Descriptor() ([]byte, []int) {
-	return file_genesis_v1_types_proto_rawDescGZIP(), []int{1}
+	return file_genesis_v1_types_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *InstanceConfig) GetCloudProvider() CloudProvider {
@@ -272,7 +322,7 @@ type InstanceResources struct {
 func (x *InstanceResources) Reset() {
 	*x = InstanceResources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_genesis_v1_types_proto_msgTypes[2]
+		mi := &file_genesis_v1_types_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -285,7 +335,7 @@ func (x *InstanceResources) String() string {
 func (*InstanceResources) ProtoMessage() {}
 
 func (x *InstanceResources) ProtoReflect() protoreflect.Message {
-	mi := &file_genesis_v1_types_proto_msgTypes[2]
+	mi := &file_genesis_v1_types_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +348,7 @@ func (x *InstanceResources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceResources.ProtoReflect.Descriptor instead.
 func (*InstanceResources) Descriptor() ([]byte, []int) {
-	return file_genesis_v1_types_proto_rawDescGZIP(), []int{2}
+	return file_genesis_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *InstanceResources) GetCpuCount() uint32 {
@@ -337,7 +387,7 @@ type GPUResources struct {
 func (x *GPUResources) Reset() {
 	*x = GPUResources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_genesis_v1_types_proto_msgTypes[3]
+		mi := &file_genesis_v1_types_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -350,7 +400,7 @@ func (x *GPUResources) String() string {
 func (*GPUResources) ProtoMessage() {}
 
 func (x *GPUResources) ProtoReflect() protoreflect.Message {
-	mi := &file_genesis_v1_types_proto_msgTypes[3]
+	mi := &file_genesis_v1_types_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +413,7 @@ func (x *GPUResources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GPUResources.ProtoReflect.Descriptor instead.
 func (*GPUResources) Descriptor() ([]byte, []int) {
-	return file_genesis_v1_types_proto_rawDescGZIP(), []int{3}
+	return file_genesis_v1_types_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GPUResources) GetKind() GPUKind {
@@ -380,6 +430,74 @@ func (x *GPUResources) GetCount() uint32 {
 	return 0
 }
 
+// InstanceUtilization describes how much of an instance's resources are used. All values are
+// fractions between 0 and 1.
+type InstanceUtilization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fraction of CPU time that is used across all CPUs.
+	Cpu float64 `protobuf:"fixed64,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// The fraction of memory that is used.
+	Memory float64 `protobuf:"fixed64,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// The fraction of GPU time that is used across all GPUs. Zero if the instance has no GPUs.
+	Gpu float64 `protobuf:"fixed64,3,opt,name=gpu,proto3" json:"gpu,omitempty"`
+}
+
+func (x *InstanceUtilization) Reset() {
+	*x = InstanceUtilization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_genesis_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceUtilization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceUtilization) ProtoMessage() {}
+
+func (x *InstanceUtilization) ProtoReflect() protoreflect.Message {
+	mi := &file_genesis_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceUtilization.ProtoReflect.Descriptor instead.
+func (*InstanceUtilization) Descriptor() ([]byte, []int) {
+	return file_genesis_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InstanceUtilization) GetCpu() float64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *InstanceUtilization) GetMemory() float64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *InstanceUtilization) GetGpu() float64 {
+	if x != nil {
+		return x.Gpu
+	}
+	return 0
+}
+
 var File_genesis_v1_types_proto protoreflect.FileDescriptor
 
 var file_genesis_v1_types_proto_rawDesc = []byte{
@@ -389,57 +507,70 @@ var file_genesis_v1_types_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x24, 0x0a,
 	0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4c, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75,
-	0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01,
-	0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x7a, 0x6f, 0x6e,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x53, 0x70, 0x6f, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x12, 0x24, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70,
-	0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x28, 0x80, 0x08,
-	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x50, 0x55, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
-	0x03, 0x67, 0x70, 0x75, 0x22, 0x62, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x50, 0x55, 0x4b, 0x69, 0x6e, 0x64, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10,
-	0x01, 0x20, 0x00, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28,
-	0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0xe4, 0x01, 0x0a, 0x07, 0x47, 0x50, 0x55,
-	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16,
-	0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41,
-	0x5f, 0x4b, 0x38, 0x30, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41, 0x5f, 0x4d, 0x36, 0x30, 0x10, 0x02, 0x12, 0x17,
-	0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41,
-	0x5f, 0x50, 0x31, 0x30, 0x30, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x4b,
-	0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41, 0x5f, 0x50, 0x34, 0x10, 0x04, 0x12, 0x17,
-	0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41,
-	0x5f, 0x56, 0x31, 0x30, 0x30, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x4b,
-	0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41, 0x5f, 0x54, 0x34, 0x10, 0x06, 0x12, 0x17,
-	0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41,
-	0x5f, 0x41, 0x31, 0x30, 0x30, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4b,
-	0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41, 0x5f, 0x41, 0x31, 0x30, 0x10, 0x08, 0x2a,
-	0x81, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49,
-	0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49,
-	0x44, 0x45, 0x52, 0x5f, 0x41, 0x4d, 0x41, 0x5a, 0x4f, 0x4e, 0x5f, 0x57, 0x45, 0x42, 0x5f, 0x53,
-	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x53, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4c, 0x4f,
-	0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x4f, 0x4f, 0x47,
-	0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52,
-	0x4d, 0x10, 0x02, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x6f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x66, 0x6c,
-	0x65, 0x65, 0x74, 0x2e, 0x69, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x94,
+	0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x4c, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00,
+	0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x1b, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x73, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
+	0x73, 0x53, 0x70, 0x6f, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x63,
+	0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x28, 0x80, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x50,
+	0x55, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x03, 0x67, 0x70, 0x75, 0x22,
+	0x62, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x33, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x50, 0x55, 0x4b, 0x69,
+	0x6e, 0x64, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x03, 0x63,
+	0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52,
+	0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x03, 0x67,
+	0x70, 0x75, 0x2a, 0xe4, 0x01, 0x0a, 0x07, 0x47, 0x50, 0x55, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x18,
+	0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41, 0x5f, 0x4b, 0x38, 0x30, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53,
+	0x4c, 0x41, 0x5f, 0x4d, 0x36, 0x30, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41, 0x5f, 0x50, 0x31, 0x30, 0x30, 0x10,
+	0x03, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45,
+	0x53, 0x4c, 0x41, 0x5f, 0x50, 0x34, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41, 0x5f, 0x56, 0x31, 0x30, 0x30, 0x10,
+	0x05, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45,
+	0x53, 0x4c, 0x41, 0x5f, 0x54, 0x34, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x53, 0x4c, 0x41, 0x5f, 0x41, 0x31, 0x30, 0x30, 0x10,
+	0x07, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x45,
+	0x53, 0x4c, 0x41, 0x5f, 0x41, 0x31, 0x30, 0x10, 0x08, 0x2a, 0x81, 0x01, 0x0a, 0x0d, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x43,
+	0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x4d,
+	0x41, 0x5a, 0x4f, 0x4e, 0x5f, 0x57, 0x45, 0x42, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x53, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f,
+	0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x4f,
+	0x55, 0x44, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x02, 0x42, 0x30, 0x5a,
+	0x2e, 0x67, 0x6f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x2e, 0x69, 0x6f,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -455,18 +586,20 @@ func file_genesis_v1_types_proto_rawDescGZIP() []byte {
 }
 
 var file_genesis_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_genesis_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_genesis_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_genesis_v1_types_proto_goTypes = []interface{}{
-	(GPUKind)(0),              // 0: genesis.v1.GPUKind
-	(CloudProvider)(0),        // 1: genesis.v1.CloudProvider
-	(*Instance)(nil),          // 2: genesis.v1.Instance
-	(*InstanceConfig)(nil),    // 3: genesis.v1.InstanceConfig
-	(*InstanceResources)(nil), // 4: genesis.v1.InstanceResources
-	(*GPUResources)(nil),      // 5: genesis.v1.GPUResources
+	(GPUKind)(0),                // 0: genesis.v1.GPUKind
+	(CloudProvider)(0),          // 1: genesis.v1.CloudProvider
+	(*Instance)(nil),            // 2: genesis.v1.Instance
+	(*InstanceGroup)(nil),       // 3: genesis.v1.InstanceGroup
+	(*InstanceConfig)(nil),      // 4: genesis.v1.InstanceConfig
+	(*InstanceResources)(nil),   // 5: genesis.v1.InstanceResources
+	(*GPUResources)(nil),        // 6: genesis.v1.GPUResources
+	(*InstanceUtilization)(nil), // 7: genesis.v1.InstanceUtilization
 }
 var file_genesis_v1_types_proto_depIdxs = []int32{
 	1, // 0: genesis.v1.InstanceConfig.cloud_provider:type_name -> genesis.v1.CloudProvider
-	5, // 1: genesis.v1.InstanceResources.gpu:type_name -> genesis.v1.GPUResources
+	6, // 1: genesis.v1.InstanceResources.gpu:type_name -> genesis.v1.GPUResources
 	0, // 2: genesis.v1.GPUResources.kind:type_name -> genesis.v1.GPUKind
 	3, // [3:3] is the sub-list for method output_type
 	3, // [3:3] is the sub-list for method input_type
@@ -494,7 +627,7 @@ func file_genesis_v1_types_proto_init() {
 			}
 		}
 		file_genesis_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceConfig); i {
+			switch v := v.(*InstanceGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -506,7 +639,7 @@ func file_genesis_v1_types_proto_init() {
 			}
 		}
 		file_genesis_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstanceResources); i {
+			switch v := v.(*InstanceConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -518,6 +651,18 @@ func file_genesis_v1_types_proto_init() {
 			}
 		}
 		file_genesis_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceResources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_genesis_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GPUResources); i {
 			case 0:
 				return &v.state
@@ -529,6 +674,18 @@ func file_genesis_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_genesis_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceUtilization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -536,7 +693,7 @@ func file_genesis_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_genesis_v1_types_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
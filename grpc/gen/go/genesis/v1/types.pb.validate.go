@@ -157,6 +157,126 @@ var _ interface {
 	ErrorName() string
 } = InstanceValidationError{}
 
+// Validate checks the field values on InstanceGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InstanceGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InstanceGroupMultiError, or
+// nil if none found.
+func (m *InstanceGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = InstanceGroupValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InstanceGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *InstanceGroup) _validateUuid(uuid string) error {
+	if matched := _types_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// InstanceGroupMultiError is an error wrapping multiple validation errors
+// returned by InstanceGroup.ValidateAll() if the designated constraints
+// aren't met.
+type InstanceGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InstanceGroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InstanceGroupMultiError) AllErrors() []error { return m }
+
+// InstanceGroupValidationError is the validation error returned by
+// InstanceGroup.Validate if the designated constraints aren't met.
+type InstanceGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceGroupValidationError) ErrorName() string { return "InstanceGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InstanceGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstanceGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceGroupValidationError{}
+
 // Validate checks the field values on InstanceConfig with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -584,3 +704,138 @@ var _ interface {
 var _GPUResources_Kind_NotInLookup = map[GPUKind]struct{}{
 	0: {},
 }
+
+// Validate checks the field values on InstanceUtilization with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InstanceUtilization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceUtilization with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InstanceUtilizationMultiError, or nil if none found.
+func (m *InstanceUtilization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceUtilization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetCpu(); val < 0 || val > 1 {
+		err := InstanceUtilizationValidationError{
+			field:  "Cpu",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMemory(); val < 0 || val > 1 {
+		err := InstanceUtilizationValidationError{
+			field:  "Memory",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetGpu(); val < 0 || val > 1 {
+		err := InstanceUtilizationValidationError{
+			field:  "Gpu",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InstanceUtilizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// InstanceUtilizationMultiError is an error wrapping multiple validation
+// errors returned by InstanceUtilization.ValidateAll() if the designated
+// constraints aren't met.
+type InstanceUtilizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InstanceUtilizationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InstanceUtilizationMultiError) AllErrors() []error { return m }
+
+// InstanceUtilizationValidationError is the validation error returned by
+// InstanceUtilization.Validate if the designated constraints aren't met.
+type InstanceUtilizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceUtilizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceUtilizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceUtilizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceUtilizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceUtilizationValidationError) ErrorName() string {
+	return "InstanceUtilizationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InstanceUtilizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstanceUtilization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceUtilizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceUtilizationValidationError{}
@@ -21,10 +21,18 @@ type Publisher interface {
 	// has been published, an error is returned. Depending on the implementation, message delivery
 	// might still continue and the result of that delivery is logged in the background.
 	//
-	// The key passed to this function is purely informational and is not provided to consumers. It
-	// serves as metadata that the message queue can use internally to partition messages.
+	// The key passed to this function serves as metadata that the message queue can use internally
+	// to partition messages. Consumers can access it via `Subscriber.ProcessMessages`.
 	PublishSync(ctx context.Context, key uuid.UUID, message proto.Message) error
 
+	// PublishMessage is similar to Publish, however, it publishes the payload of the given envelope
+	// along with its key, headers and, if set, its timestamp. The envelope's position is ignored.
+	PublishMessage(message Message) error
+
+	// PublishMessageSync is similar to PublishSync, however, it publishes the given envelope (see
+	// `PublishMessage`).
+	PublishMessageSync(ctx context.Context, message Message) error
+
 	// Flush waits for all messages to be delivered to the message queue. It blocks until all
 	// messages have been delivered or the given context is cancelled. If the cancellation of the
 	// context causes messages to not be delivered, an error is returned. In any case, this
@@ -55,6 +63,12 @@ type Subscriber interface {
 	// uphold delivery guarantees, it should be called exactly once on a particular subscriber.
 	Process(ctx context.Context, execute func(context.Context, []proto.Message) error) error
 
+	// ProcessMessages is similar to Process, however, it delivers message envelopes which provide
+	// access to the messages' metadata such as their keys, headers and positions. The same
+	// restrictions apply, i.e. only one of Process and ProcessMessages may be called on a
+	// particular subscriber.
+	ProcessMessages(ctx context.Context, execute func(context.Context, []Message) error) error
+
 	// Close ensures that the subscriber is properly cleaned up.
 	Close()
 }
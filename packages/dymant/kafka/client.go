@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"fmt"
+	"time"
 
 	"go.taskfleet.io/packages/dymant"
 	"go.uber.org/zap"
@@ -81,3 +82,56 @@ func (c *Client) Subscriber(
 		zap.String(logKeyComponent, "subscriber"),
 	))
 }
+
+// Reader returns a new consumer for the given topic which is not part of any consumer group. It
+// consumes all partitions of the topic, starting with the first messages published at or after
+// the given time, and never commits offsets. Hence, readers do not leave any state in Kafka and
+// multiple readers may consume the same topic independently. Readers are particularly useful for
+// ephemeral consumers such as command-line tools. The fetch level is ignored if provided as
+// option, all other subscriber options are supported.
+func (c *Client) Reader(
+	topic string, start time.Time, message proto.Message, options ...SubscriberOption,
+) (dymant.Subscriber, error) {
+	if topic == "" {
+		return nil, fmt.Errorf("cannot read from empty topic")
+	}
+	// A group ID is required by the consumer, however, it is never used to join a group
+	config, err := c.config.consumerConfig(c.config.id, options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration: %s", err)
+	}
+	config["enable.auto.commit"] = false
+	subConfig := subscriberConfig{}
+	for _, option := range options {
+		option.configApply(&subConfig)
+	}
+	subConfig.fetch = FetchAny
+	return newReader(topic, start, config, subConfig, message, c.logger.With(
+		zap.String(logKeyTopic, topic),
+		zap.String(logKeyComponent, "reader"),
+	))
+}
+
+// TypedPublisher returns a new producer for the given topic which publishes messages of type T,
+// see `Client.Publisher`.
+func TypedPublisher[T proto.Message](
+	c *Client, topic string, options ...PublisherOption,
+) (dymant.TypedPublisher[T], error) {
+	publisher, err := c.Publisher(topic, options...)
+	if err != nil {
+		return dymant.TypedPublisher[T]{}, err
+	}
+	return dymant.NewTypedPublisher[T](publisher), nil
+}
+
+// TypedSubscriber returns a new consumer group for the given topic which delivers messages of type
+// T, see `Client.Subscriber`.
+func TypedSubscriber[T proto.Message](
+	c *Client, topic, group string, options ...SubscriberOption,
+) (dymant.TypedSubscriber[T], error) {
+	subscriber, err := c.Subscriber(topic, group, dymant.MessageTemplate[T](), options...)
+	if err != nil {
+		return dymant.TypedSubscriber[T]{}, err
+	}
+	return dymant.NewTypedSubscriber[T](subscriber), nil
+}
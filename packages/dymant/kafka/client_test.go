@@ -1,11 +1,16 @@
 package kafka
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.taskfleet.io/packages/dymant"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestPublishSubscribeSync(t *testing.T) {
@@ -37,3 +42,105 @@ func TestPublishSubscribeAsync(t *testing.T) {
 	fixture.await()
 	assert.Equal(t, <-publishCount, <-subscribeCount)
 }
+
+func TestTypedPublishSubscribe(t *testing.T) {
+	fixture := newPubsubFixture(t)
+	ctx, cancel := context.WithTimeout(fixture.ctx, 3*time.Second)
+	defer cancel()
+
+	publisher, err := TypedPublisher[*timestamppb.Timestamp](client, fixture.topic.name)
+	require.Nil(t, err)
+	timestamp := timestamppb.Now()
+	require.Nil(t, publisher.PublishSync(ctx, uuid.New(), timestamp))
+	require.Nil(t, publisher.Flush(ctx))
+
+	subscriber, err := TypedSubscriber[*timestamppb.Timestamp](
+		client, fixture.topic.name, uuid.NewString(),
+	)
+	require.Nil(t, err)
+	defer subscriber.Close()
+
+	var received []*timestamppb.Timestamp
+	err = subscriber.Process(ctx,
+		func(ctx context.Context, messages []*timestamppb.Timestamp) error {
+			received = append(received, messages...)
+			cancel()
+			return nil
+		},
+	)
+	assert.True(t, dymant.IsErrContext(err))
+	require.Len(t, received, 1)
+	assert.True(t, proto.Equal(timestamp, received[0]))
+}
+
+func TestPublishSubscribeMessages(t *testing.T) {
+	fixture := newPubsubFixture(t)
+	ctx, cancel := context.WithTimeout(fixture.ctx, 3*time.Second)
+	defer cancel()
+
+	publisher, err := client.Publisher(fixture.topic.name)
+	require.Nil(t, err)
+	message := dymant.Message{
+		Key:       uuid.New(),
+		Headers:   map[string]string{"trace-id": "abc"},
+		Timestamp: time.UnixMilli(time.Now().UnixMilli()),
+		Payload:   timestamppb.Now(),
+	}
+	require.Nil(t, publisher.PublishMessageSync(ctx, message))
+	require.Nil(t, publisher.Flush(ctx))
+
+	subscriber, err := client.Subscriber(
+		fixture.topic.name, uuid.NewString(), &timestamppb.Timestamp{},
+	)
+	require.Nil(t, err)
+	defer subscriber.Close()
+
+	var received []dymant.Message
+	err = subscriber.ProcessMessages(ctx,
+		func(ctx context.Context, messages []dymant.Message) error {
+			received = append(received, messages...)
+			cancel()
+			return nil
+		},
+	)
+	assert.True(t, dymant.IsErrContext(err))
+	require.Len(t, received, 1)
+	assert.Equal(t, message.Key, received[0].Key)
+	assert.Equal(t, message.Headers, received[0].Headers)
+	assert.True(t, message.Timestamp.Equal(received[0].Timestamp))
+	assert.Equal(t, fixture.topic.name, received[0].Position.Topic)
+	assert.True(t, proto.Equal(message.Payload, received[0].Payload))
+}
+
+func TestReader(t *testing.T) {
+	fixture := newPubsubFixture(t)
+	ctx, cancel := context.WithTimeout(fixture.ctx, 3*time.Second)
+	defer cancel()
+
+	publisher, err := client.Publisher(fixture.topic.name)
+	require.Nil(t, err)
+	old := timestamppb.New(time.Now().Add(-time.Hour))
+	require.Nil(t, publisher.PublishMessageSync(ctx, dymant.Message{
+		Key: uuid.New(), Timestamp: old.AsTime(), Payload: old,
+	}))
+	recent := timestamppb.Now()
+	require.Nil(t, publisher.PublishSync(ctx, uuid.New(), recent))
+	require.Nil(t, publisher.Flush(ctx))
+
+	// Only messages published after the start time must be read
+	reader, err := client.Reader(
+		fixture.topic.name, time.Now().Add(-time.Minute), &timestamppb.Timestamp{},
+	)
+	require.Nil(t, err)
+	defer reader.Close()
+
+	var received []proto.Message
+	err = reader.Process(ctx, func(ctx context.Context, messages []proto.Message) error {
+		received = append(received, messages...)
+		cancel()
+		return nil
+	})
+	assert.True(t, dymant.IsErrContext(err))
+	require.Len(t, received, 1)
+	assert.True(t, proto.Equal(recent, received[0]))
+}
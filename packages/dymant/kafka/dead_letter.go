@@ -0,0 +1,78 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"go.taskfleet.io/packages/dymant"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// HeaderDeadLetterError is the header of dead-lettered messages which describes the error
+	// that caused the message to be dead-lettered.
+	HeaderDeadLetterError = "dymant-dead-letter-error"
+	// HeaderDeadLetterTopic is the header of dead-lettered messages which provides the topic
+	// from which the message was consumed.
+	HeaderDeadLetterTopic = "dymant-dead-letter-topic"
+	// HeaderDeadLetterPartition is the header of dead-lettered messages which provides the
+	// partition from which the message was consumed.
+	HeaderDeadLetterPartition = "dymant-dead-letter-partition"
+	// HeaderDeadLetterOffset is the header of dead-lettered messages which provides the offset of
+	// the message within its source partition.
+	HeaderDeadLetterOffset = "dymant-dead-letter-offset"
+)
+
+// unmarshalError is returned when polling a message whose value cannot be unmarshaled.
+type unmarshalError struct {
+	message dymant.Message
+	err     error
+}
+
+func (e unmarshalError) Error() string {
+	return fmt.Sprintf("failed to unmarshal message: %s", e.err)
+}
+
+// deadLetter publishes the given messages to the subscriber's dead-letter queue, recording the
+// provided error as cause.
+func (c *subscriber) deadLetter(
+	ctx context.Context, messages []dymant.Message, cause error,
+) error {
+	for _, message := range messages {
+		c.logger.Warn("publishing message to dead-letter queue",
+			zap.Int32(logKeyPartition, message.Position.Partition),
+			zap.Int64(logKeyOffset, message.Position.Offset),
+			zap.Error(cause),
+		)
+		if err := c.config.deadLetter.PublishMessageSync(
+			ctx, deadLetterMessage(message, cause),
+		); err != nil {
+			return fmt.Errorf("failed to publish message to dead-letter queue: %s", err)
+		}
+	}
+	return nil
+}
+
+// deadLetterMessage returns the message to publish to a dead-letter queue for the given consumed
+// message.
+func deadLetterMessage(message dymant.Message, cause error) dymant.Message {
+	headers := make(map[string]string, len(message.Headers)+4)
+	for key, value := range message.Headers {
+		headers[key] = value
+	}
+	headers[HeaderDeadLetterError] = cause.Error()
+	headers[HeaderDeadLetterTopic] = message.Position.Topic
+	headers[HeaderDeadLetterPartition] = fmt.Sprintf("%d", message.Position.Partition)
+	headers[HeaderDeadLetterOffset] = fmt.Sprintf("%d", message.Position.Offset)
+	return dymant.Message{Key: message.Key, Headers: headers, Payload: message.Payload}
+}
+
+// rawPayload returns a message which is serialized to exactly the given bytes, regardless of
+// whether they are a valid serialization of any message.
+func rawPayload(value []byte) proto.Message {
+	message := &emptypb.Empty{}
+	message.ProtoReflect().SetUnknown(value)
+	return message
+}
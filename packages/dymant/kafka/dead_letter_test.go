@@ -0,0 +1,40 @@
+package kafka
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.taskfleet.io/packages/dymant"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDeadLetterMessage(t *testing.T) {
+	message := dymant.Message{
+		Key:      uuid.New(),
+		Headers:  map[string]string{"trace-id": "abc"},
+		Position: dymant.Position{Topic: "events", Partition: 2, Offset: 42},
+		Payload:  timestamppb.Now(),
+	}
+	result := deadLetterMessage(message, fmt.Errorf("failed"))
+	assert.Equal(t, message.Key, result.Key)
+	assert.Equal(t, message.Payload, result.Payload)
+	assert.Equal(t, map[string]string{
+		"trace-id":                "abc",
+		HeaderDeadLetterError:     "failed",
+		HeaderDeadLetterTopic:     "events",
+		HeaderDeadLetterPartition: "2",
+		HeaderDeadLetterOffset:    "42",
+	}, result.Headers)
+	assert.Len(t, message.Headers, 1)
+}
+
+func TestRawPayload(t *testing.T) {
+	value := []byte{0xff, 0x01}
+	encoded, err := proto.Marshal(rawPayload(value))
+	require.Nil(t, err)
+	assert.Equal(t, value, encoded)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 	"github.com/google/uuid"
+	"go.taskfleet.io/packages/dymant"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
@@ -41,7 +42,15 @@ func newPublisher(topic string, config kafka.ConfigMap, logger *zap.Logger) (*pu
 //-------------------------------------------------------------------------------------------------
 
 func (p *publisher) Publish(key uuid.UUID, message proto.Message) error {
-	msg, err := p.buildMessage(key, message)
+	return p.PublishMessage(dymant.Message{Key: key, Payload: message})
+}
+
+func (p *publisher) PublishSync(ctx context.Context, key uuid.UUID, message proto.Message) error {
+	return p.PublishMessageSync(ctx, dymant.Message{Key: key, Payload: message})
+}
+
+func (p *publisher) PublishMessage(message dymant.Message) error {
+	msg, err := p.buildMessage(message)
 	if err != nil {
 		return err
 	}
@@ -51,8 +60,8 @@ func (p *publisher) Publish(key uuid.UUID, message proto.Message) error {
 	return nil
 }
 
-func (p *publisher) PublishSync(ctx context.Context, key uuid.UUID, message proto.Message) error {
-	msg, err := p.buildMessage(key, message)
+func (p *publisher) PublishMessageSync(ctx context.Context, message dymant.Message) error {
+	msg, err := p.buildMessage(message)
 	if err != nil {
 		return err
 	}
@@ -137,16 +146,27 @@ func (p *publisher) awaitRemaining(ctx context.Context) error {
 // SERIALIZATION
 //-------------------------------------------------------------------------------------------------
 
-func (p *publisher) buildMessage(key uuid.UUID, message proto.Message) (*kafka.Message, error) {
-	encoded, err := proto.Marshal(message)
+func (p *publisher) buildMessage(message dymant.Message) (*kafka.Message, error) {
+	encoded, err := proto.Marshal(message.Payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed marshalling message: %s", err)
 	}
 
-	// Need kafka.PartitionAny or it is published to partition 0
+	var headers []kafka.Header
+	if len(message.Headers) > 0 {
+		headers = make([]kafka.Header, 0, len(message.Headers))
+		for key, value := range message.Headers {
+			headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+		}
+	}
+
+	// Need kafka.PartitionAny or it is published to partition 0. If no timestamp is set, the
+	// producer uses the current time.
 	return &kafka.Message{
 		TopicPartition: kafka.TopicPartition{Topic: &p.topic, Partition: kafka.PartitionAny},
-		Key:            key[:],
+		Key:            message.Key[:],
 		Value:          encoded,
+		Headers:        headers,
+		Timestamp:      message.Timestamp,
 	}, nil
 }
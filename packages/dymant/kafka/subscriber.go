@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/google/uuid"
+	"go.taskfleet.io/packages/dymant"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
@@ -17,7 +19,9 @@ type subscriber struct {
 	logger          *zap.Logger
 	consumer        *kafka.Consumer
 	messageTemplate proto.Message
-	buf             []proto.Message
+	buf             []dymant.Message
+	// Whether messages were dead-lettered without their offsets being committed yet.
+	skipped bool
 }
 
 type subscriberConfig struct {
@@ -25,6 +29,8 @@ type subscriberConfig struct {
 	callbackTimeout  time.Duration
 	batchBufferSize  int
 	batchAggregation time.Duration
+	deadLetter       dymant.Publisher
+	retryPolicy      dymant.RetryPolicy
 }
 
 func newSubscriber(
@@ -39,15 +45,44 @@ func newSubscriber(
 		return nil, fmt.Errorf("failed to create kafka consumer: %s", err)
 	}
 	if err := kafkaConsumer.Subscribe(topic, consumerCallback(logger)); err != nil {
+		kafkaConsumer.Close() // nolint:errcheck
 		return nil, fmt.Errorf("failed to initiate subscription for topic: %s", err)
 	}
+	return initSubscriber(topic, kafkaConsumer, subscriberConfig, message, logger), nil
+}
 
+func newReader(
+	topic string,
+	start time.Time,
+	config kafka.ConfigMap,
+	subscriberConfig subscriberConfig,
+	message proto.Message,
+	logger *zap.Logger,
+) (*subscriber, error) {
+	kafkaConsumer, err := kafka.NewConsumer(&config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka consumer: %s", err)
+	}
+	if err := assignFrom(kafkaConsumer, topic, start); err != nil {
+		kafkaConsumer.Close() // nolint:errcheck
+		return nil, err
+	}
+	return initSubscriber(topic, kafkaConsumer, subscriberConfig, message, logger), nil
+}
+
+func initSubscriber(
+	topic string,
+	kafkaConsumer *kafka.Consumer,
+	subscriberConfig subscriberConfig,
+	message proto.Message,
+	logger *zap.Logger,
+) *subscriber {
 	// Initialize buffer
-	var buf []proto.Message
+	var buf []dymant.Message
 	if subscriberConfig.batchAggregation <= 0 || subscriberConfig.batchBufferSize <= 0 {
-		buf = make([]proto.Message, 0, 1)
+		buf = make([]dymant.Message, 0, 1)
 	} else {
-		buf = make([]proto.Message, 0, subscriberConfig.batchBufferSize)
+		buf = make([]dymant.Message, 0, subscriberConfig.batchBufferSize)
 	}
 
 	// Create subscriber
@@ -58,13 +93,21 @@ func newSubscriber(
 		consumer:        kafkaConsumer,
 		messageTemplate: message,
 		buf:             buf,
-	}, nil
+	}
 }
 
 //-------------------------------------------------------------------------------------------------
 
 func (c *subscriber) Process(
 	ctx context.Context, execute func(context.Context, []proto.Message) error,
+) error {
+	return c.ProcessMessages(ctx, func(ctx context.Context, messages []dymant.Message) error {
+		return execute(ctx, dymant.Payloads(messages))
+	})
+}
+
+func (c *subscriber) ProcessMessages(
+	ctx context.Context, execute func(context.Context, []dymant.Message) error,
 ) error {
 	deadline := time.Now().Add(c.config.batchAggregation)
 	for {
@@ -81,6 +124,13 @@ func (c *subscriber) Process(
 		// processing might further delay messages
 		deadline = time.Now().Add(c.config.batchAggregation)
 
+		// If we only skipped messages, we need to commit past them nonetheless
+		if len(c.buf) == 0 && c.skipped && c.config.fetch != FetchAny {
+			if err := c.commit(); err != nil {
+				return err
+			}
+		}
+
 		// If we didn't receive messages in the batch, we don't need to return anything
 		if len(c.buf) > 0 {
 			// For at-most-once delivery, we commit the consumer offset before delivering to
@@ -91,13 +141,21 @@ func (c *subscriber) Process(
 				}
 			}
 
-			callbackCtx, cancel := c.callbackContext(ctx)
-			err := func() error {
+			// Every attempt is subject to the processing timeout
+			err := c.config.retryPolicy.Execute(ctx, func(ctx context.Context) error {
+				callbackCtx, cancel := c.callbackContext(ctx)
 				defer cancel()
 				return execute(callbackCtx, c.buf)
-			}()
+			})
 			if err != nil {
-				return err
+				// If a dead-letter queue is configured, failed messages are skipped unless
+				// processing was cancelled by the caller
+				if c.config.deadLetter == nil || ctx.Err() != nil {
+					return err
+				}
+				if err := c.deadLetter(ctx, c.buf, err); err != nil {
+					return err
+				}
 			}
 
 			// For at-least-once delivery, we can commit the offset as soon as the messages are
@@ -119,6 +177,9 @@ func (c *subscriber) Close() {
 
 //–------------------------------------------------------------------------------------------------
 
+// metadataTimeoutMs is the timeout for requests querying metadata of topics.
+const metadataTimeoutMs = 10000
+
 var (
 	errPartitionsRevoked = errors.New("partitions revoked")
 	errNoEvent           = errors.New("no event")
@@ -154,6 +215,16 @@ func (c *subscriber) next(ctx context.Context, deadline time.Time) error {
 				// In case no event occurred, we can just continue. Since the timeout should be
 				// negative, nothing will happen.
 				continue
+			} else if unmarshal, ok := err.(unmarshalError); ok && c.config.deadLetter != nil {
+				// If the message cannot be unmarshaled, we skip it after publishing it to the
+				// dead-letter queue.
+				if err := c.deadLetter(
+					ctx, []dymant.Message{unmarshal.message}, unmarshal,
+				); err != nil {
+					return err
+				}
+				c.skipped = true
+				continue
 			} else if err == errPartitionsRevoked {
 				// If partitions are revoked, we need to clear all messages that we have received
 				// in the current iteration. The messages of the partitions that will be assigned
@@ -164,7 +235,7 @@ func (c *subscriber) next(ctx context.Context, deadline time.Time) error {
 				return err
 			}
 		}
-		if msg == nil {
+		if msg.Payload == nil {
 			// Might occur for unexpected non-error events -- also, the above errors don't
 			// necessarily terminate the iteration
 			continue
@@ -184,11 +255,11 @@ func (c *subscriber) timeout(deadline time.Time) time.Duration {
 	return min(time.Until(deadline), 100*time.Millisecond)
 }
 
-func (c *subscriber) poll(timeoutMs int) (proto.Message, error) {
+func (c *subscriber) poll(timeoutMs int) (dymant.Message, error) {
 	event := c.consumer.Poll(timeoutMs)
 	if event == nil {
 		// timeout exceeded
-		return nil, errNoEvent
+		return dymant.Message{}, errNoEvent
 	}
 
 	// Process the event
@@ -197,20 +268,22 @@ func (c *subscriber) poll(timeoutMs int) (proto.Message, error) {
 		// First, we log the message and check for an error
 		logConsumed(c.logger, item)
 		if item.TopicPartition.Error != nil {
-			return nil, fmt.Errorf("failed to read message: %s", item.TopicPartition.Error)
+			return dymant.Message{}, fmt.Errorf(
+				"failed to read message: %s", item.TopicPartition.Error,
+			)
 		}
 
 		// Finally, we can parse it
 		msg := proto.Clone(c.messageTemplate)
 		if err := proto.Unmarshal(item.Value, msg); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal message: %s", err)
+			return dymant.Message{}, unmarshalError{envelope(item, rawPayload(item.Value)), err}
 		}
-		return msg, nil
+		return envelope(item, msg), nil
 	case kafka.Error:
 		// Errors are informational, so we only log them except if all brokers are down
 		if item.Code() == kafka.ErrAllBrokersDown {
 			c.logger.Error("failed to connect to all brokers", zap.Error(item))
-			return nil, item
+			return dymant.Message{}, item
 		}
 		c.logger.Warn("received error from Kafka", zap.Error(item))
 	case kafka.OffsetsCommitted:
@@ -223,14 +296,21 @@ func (c *subscriber) poll(timeoutMs int) (proto.Message, error) {
 		c.logger.Debug("received unexpected event", zap.String("event", item.String()))
 	}
 
-	return nil, nil
+	return dymant.Message{}, nil
 }
 
 func (c *subscriber) commit() error {
 	offsets, err := c.consumer.Commit()
 	if err != nil {
+		// There is nothing to commit, e.g. if partitions were revoked after skipping messages
+		var kafkaErr kafka.Error
+		if errors.As(err, &kafkaErr) && kafkaErr.Code() == kafka.ErrNoOffset {
+			c.skipped = false
+			return nil
+		}
 		return err
 	}
+	c.skipped = false
 	if c.logger.Core().Enabled(zap.DebugLevel) {
 		c.logger.Debug("committed offsets", logFieldsOffsets(offsets)...)
 	}
@@ -269,6 +349,77 @@ func consumerCallback(logger *zap.Logger) func(*kafka.Consumer, kafka.Event) err
 	}
 }
 
+// assignFrom assigns all partitions of the given topic to the consumer, starting at the offsets of
+// the first messages published at or after the given time.
+func assignFrom(consumer *kafka.Consumer, topic string, start time.Time) error {
+	metadata, err := consumer.GetMetadata(&topic, false, metadataTimeoutMs)
+	if err != nil {
+		return fmt.Errorf("failed to get metadata of topic: %s", err)
+	}
+	topicMetadata, ok := metadata.Topics[topic]
+	if !ok || topicMetadata.Error.Code() != kafka.ErrNoError {
+		return fmt.Errorf("failed to get partitions of topic: %s", topicMetadata.Error)
+	}
+
+	times := make([]kafka.TopicPartition, 0, len(topicMetadata.Partitions))
+	for _, partition := range topicMetadata.Partitions {
+		times = append(times, kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: partition.ID,
+			Offset:    kafka.Offset(start.UnixMilli()),
+		})
+	}
+	// Partitions without messages after the given time are assigned at their end
+	offsets, err := consumer.OffsetsForTimes(times, metadataTimeoutMs)
+	if err != nil {
+		return fmt.Errorf("failed to get offsets of topic: %s", err)
+	}
+	for _, offset := range offsets {
+		if offset.Error != nil {
+			return fmt.Errorf(
+				"failed to get offset of partition %d: %s", offset.Partition, offset.Error,
+			)
+		}
+	}
+	if err := consumer.Assign(offsets); err != nil {
+		return fmt.Errorf("failed to assign partitions of topic: %s", err)
+	}
+	return nil
+}
+
+//-------------------------------------------------------------------------------------------------
+
+// envelope builds the envelope for the given consumed message and its unmarshaled payload. Keys
+// which are no valid UUIDs are mapped to `dymant.NoKey`.
+func envelope(item *kafka.Message, payload proto.Message) dymant.Message {
+	key, err := uuid.FromBytes(item.Key)
+	if err != nil {
+		key = dymant.NoKey
+	}
+	var headers map[string]string
+	if len(item.Headers) > 0 {
+		headers = make(map[string]string, len(item.Headers))
+		for _, header := range item.Headers {
+			headers[header.Key] = string(header.Value)
+		}
+	}
+	var topic string
+	if item.TopicPartition.Topic != nil {
+		topic = *item.TopicPartition.Topic
+	}
+	return dymant.Message{
+		Key:       key,
+		Headers:   headers,
+		Timestamp: item.Timestamp,
+		Position: dymant.Position{
+			Topic:     topic,
+			Partition: item.TopicPartition.Partition,
+			Offset:    int64(item.TopicPartition.Offset),
+		},
+		Payload: payload,
+	}
+}
+
 //-------------------------------------------------------------------------------------------------
 
 func min(lhs, rhs time.Duration) time.Duration {
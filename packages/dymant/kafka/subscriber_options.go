@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.taskfleet.io/packages/dymant"
 )
 
 // SubscriberOption allows to update the configuration of a Kafka subscriber.
@@ -131,3 +132,65 @@ func (c subscriberOptionTimeout) apply(config kafka.ConfigMap) error {
 func (c subscriberOptionTimeout) configApply(config *subscriberConfig) {
 	config.callbackTimeout = c.timeout
 }
+
+//-------------------------------------------------------------------------------------------------
+// RETRIES
+//-------------------------------------------------------------------------------------------------
+
+type subscriberOptionRetryPolicy struct {
+	dummySubscriberOption
+	policy dymant.RetryPolicy
+}
+
+// WithRetryPolicy sets the policy for re-delivering a batch of messages in-process if processing
+// it fails. Each attempt is subject to the timeout set via `WithProcessingTimeout`. Once the
+// policy gives up, the messages are published to the dead-letter queue if set via
+// `WithDeadLetter` or `Process` returns the error of the last attempt. Note that the consumer
+// does not poll Kafka while retrying: the total time spent on retries should therefore be lower
+// than the consumer's `max.poll.interval.ms` to prevent the consumer from leaving its group.
+//
+// If this option is not set, messages are not retried.
+func WithRetryPolicy(policy dymant.RetryPolicy) SubscriberOption {
+	return subscriberOptionRetryPolicy{policy: policy}
+}
+
+func (c subscriberOptionRetryPolicy) apply(config kafka.ConfigMap) error {
+	return nil
+}
+
+func (c subscriberOptionRetryPolicy) configApply(config *subscriberConfig) {
+	config.retryPolicy = c.policy
+}
+
+//-------------------------------------------------------------------------------------------------
+// DEAD LETTER QUEUE
+//-------------------------------------------------------------------------------------------------
+
+type subscriberOptionDeadLetter struct {
+	dummySubscriberOption
+	publisher dymant.Publisher
+}
+
+// WithDeadLetter sets a publisher for a dead-letter queue (DLQ). Messages which cannot be
+// unmarshaled as well as batches of messages whose processing failed (after exhausting the retry
+// policy set via `WithRetryPolicy`) are published to the DLQ and the subscriber continues
+// consuming subsequent messages instead of failing. If processing is cancelled via the context
+// passed to `Process`, messages are not dead-lettered.
+//
+// Dead-lettered messages retain their key, headers and payload. Messages which cannot be
+// unmarshaled are published with their original bytes, i.e. the DLQ may be consumed with the
+// same message type as the original topic. The error and the source position of the message are
+// attached as headers (see `HeaderDeadLetterError` and friends).
+//
+// If this option is not set, failures result in `Process` returning an error.
+func WithDeadLetter(publisher dymant.Publisher) SubscriberOption {
+	return subscriberOptionDeadLetter{publisher: publisher}
+}
+
+func (c subscriberOptionDeadLetter) apply(config kafka.ConfigMap) error {
+	return nil
+}
+
+func (c subscriberOptionDeadLetter) configApply(config *subscriberConfig) {
+	config.deadLetter = c.publisher
+}
@@ -1,12 +1,18 @@
 package kafka
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.taskfleet.io/packages/dymant"
+	"go.taskfleet.io/packages/dymant/memory"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestMultipleSubscribers(t *testing.T) {
@@ -54,3 +60,79 @@ func TestMultipleSubscribers(t *testing.T) {
 	assert.Greater(t, s1, 0)
 	assert.Greater(t, s2, 0)
 }
+
+func TestSubscriberDeadLetter(t *testing.T) {
+	fixture := newPubsubFixture(t)
+	ctx, cancel := context.WithTimeout(fixture.ctx, 3*time.Second)
+	defer cancel()
+
+	// Publish a message which cannot be unmarshaled, followed by two valid ones
+	publisher, err := client.Publisher(fixture.topic.name)
+	require.Nil(t, err)
+	require.Nil(t, publisher.PublishSync(ctx, dymant.NoKey, rawPayload([]byte{0xff})))
+	for i := 0; i < 2; i++ {
+		require.Nil(t, publisher.PublishSync(ctx, dymant.NoKey, timestamppb.Now()))
+	}
+	require.Nil(t, publisher.Flush(ctx))
+
+	deadLetter := memory.NewQueue(10)
+	subscriber, err := client.Subscriber(
+		fixture.topic.name, uuid.NewString(), &timestamppb.Timestamp{},
+		WithDeadLetter(deadLetter),
+	)
+	require.Nil(t, err)
+	defer subscriber.Close()
+
+	// Processing of the first valid message fails, hence, it must be dead-lettered as well
+	count := 0
+	err = subscriber.Process(ctx, func(ctx context.Context, messages []proto.Message) error {
+		count += len(messages)
+		if count == 1 {
+			return fmt.Errorf("failed to process")
+		}
+		cancel()
+		return nil
+	})
+	assert.True(t, dymant.IsErrContext(err))
+	assert.Equal(t, 2, count)
+
+	envelopes := deadLetter.GetEnvelopes()
+	require.Len(t, envelopes, 2)
+	assert.Contains(t, envelopes[0].Headers[HeaderDeadLetterError], "failed to unmarshal")
+	assert.Equal(t, "0", envelopes[0].Headers[HeaderDeadLetterOffset])
+	assert.Equal(t, "failed to process", envelopes[1].Headers[HeaderDeadLetterError])
+	assert.Equal(t, "1", envelopes[1].Headers[HeaderDeadLetterOffset])
+}
+
+func TestSubscriberRetryPolicy(t *testing.T) {
+	fixture := newPubsubFixture(t)
+	ctx, cancel := context.WithTimeout(fixture.ctx, 3*time.Second)
+	defer cancel()
+
+	publisher, err := client.Publisher(fixture.topic.name)
+	require.Nil(t, err)
+	require.Nil(t, publisher.PublishSync(ctx, dymant.NoKey, timestamppb.Now()))
+	require.Nil(t, publisher.Flush(ctx))
+
+	subscriber, err := client.Subscriber(
+		fixture.topic.name, uuid.NewString(), &timestamppb.Timestamp{},
+		WithProcessingTimeout(10*time.Millisecond),
+		WithRetryPolicy(dymant.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}),
+	)
+	require.Nil(t, err)
+	defer subscriber.Close()
+
+	// The first attempt exceeds the processing timeout, the second one succeeds
+	attempts := 0
+	err = subscriber.Process(ctx, func(ctx context.Context, messages []proto.Message) error {
+		attempts++
+		if attempts == 1 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		cancel()
+		return nil
+	})
+	assert.True(t, dymant.IsErrContext(err))
+	assert.Equal(t, 2, attempts)
+}
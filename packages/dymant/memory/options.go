@@ -0,0 +1,27 @@
+package memory
+
+import "go.taskfleet.io/packages/dymant"
+
+// Option allows to customize the in-memory queue.
+type Option interface {
+	apply(q *Queue)
+}
+
+//-------------------------------------------------------------------------------------------------
+// RETRIES
+//-------------------------------------------------------------------------------------------------
+
+type optionRetryPolicy struct {
+	policy dymant.RetryPolicy
+}
+
+// WithRetryPolicy sets the policy for re-delivering a message in-process if processing it fails.
+// Once the policy gives up, `Process` returns the error of the last attempt. If this option is not
+// set, messages are not retried.
+func WithRetryPolicy(policy dymant.RetryPolicy) Option {
+	return optionRetryPolicy{policy}
+}
+
+func (o optionRetryPolicy) apply(q *Queue) {
+	q.retryPolicy = o.policy
+}
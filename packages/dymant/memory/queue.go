@@ -3,25 +3,37 @@ package memory
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
+	"go.taskfleet.io/packages/dymant"
 	"google.golang.org/protobuf/proto"
 )
 
+// topic is the topic reported in the positions of messages consumed from a queue.
+const topic = "memory"
+
 // Queue represents a message queue that resides purely in-memory and can be used for testing
 // purposes. The queue is thread-safe and not tuned for performance. The queue never delivers
 // messages in batches.
 type Queue struct {
-	ch chan proto.Message
+	ch          chan dymant.Message
+	offset      atomic.Int64
+	retryPolicy dymant.RetryPolicy
 }
 
 // NewQueue initializes a new message queue that resides entirely in memory. The queue is both
 // a publisher and a subscriber and provides convenience methods for easily setting/getting
 // messages. The queue may grow up to the specified size.
-func NewQueue(size int) *Queue {
-	return &Queue{
-		ch: make(chan proto.Message, size),
+func NewQueue(size int, options ...Option) *Queue {
+	queue := &Queue{
+		ch: make(chan dymant.Message, size),
+	}
+	for _, option := range options {
+		option.apply(queue)
 	}
+	return queue
 }
 
 //-------------------------------------------------------------------------------------------------
@@ -30,8 +42,7 @@ func NewQueue(size int) *Queue {
 
 // Publish implements the dymant.Publisher interface.
 func (q *Queue) Publish(key uuid.UUID, message proto.Message) error {
-	q.ch <- message
-	return nil
+	return q.PublishMessage(dymant.Message{Key: key, Payload: message})
 }
 
 // PublishSync implements the dymant.Publisher interface.
@@ -39,6 +50,22 @@ func (q *Queue) PublishSync(ctx context.Context, key uuid.UUID, message proto.Me
 	return q.Publish(key, message)
 }
 
+// PublishMessage implements the dymant.Publisher interface. Messages are assigned consecutive
+// offsets in a single partition of topic "memory".
+func (q *Queue) PublishMessage(message dymant.Message) error {
+	if message.Timestamp.IsZero() {
+		message.Timestamp = time.Now()
+	}
+	message.Position = dymant.Position{Topic: topic, Offset: q.offset.Add(1) - 1}
+	q.ch <- message
+	return nil
+}
+
+// PublishMessageSync implements the dymant.Publisher interface.
+func (q *Queue) PublishMessageSync(ctx context.Context, message dymant.Message) error {
+	return q.PublishMessage(message)
+}
+
 // Flush implements the dymant.Publisher interface.
 func (q *Queue) Flush(ctx context.Context) error {
 	return nil
@@ -51,6 +78,15 @@ func (q *Queue) Flush(ctx context.Context) error {
 // Process implements the dymant.Subscriber interface.
 func (q *Queue) Process(
 	ctx context.Context, execute func(context.Context, []proto.Message) error,
+) error {
+	return q.ProcessMessages(ctx, func(ctx context.Context, messages []dymant.Message) error {
+		return execute(ctx, dymant.Payloads(messages))
+	})
+}
+
+// ProcessMessages implements the dymant.Subscriber interface.
+func (q *Queue) ProcessMessages(
+	ctx context.Context, execute func(context.Context, []dymant.Message) error,
 ) error {
 	for {
 		select {
@@ -60,7 +96,10 @@ func (q *Queue) Process(
 			if !ok {
 				return fmt.Errorf("channel closed unexpectedly")
 			}
-			if err := execute(ctx, []proto.Message{next}); err != nil {
+			err := q.retryPolicy.Execute(ctx, func(ctx context.Context) error {
+				return execute(ctx, []dymant.Message{next})
+			})
+			if err != nil {
 				return err
 			}
 		}
@@ -79,13 +118,20 @@ func (q *Queue) Close() {
 // SetMessages is a convenience function to add the provided messages to the queue.
 func (q *Queue) SetMessages(messages []proto.Message) {
 	for _, msg := range messages {
-		q.ch <- msg
+		// Publishing to the in-memory queue never fails
+		_ = q.Publish(dymant.NoKey, msg)
 	}
 }
 
 // GetMessages is a convenience function to get all messages published to the queue.
 func (q *Queue) GetMessages() []proto.Message {
-	result := make([]proto.Message, 0)
+	return dymant.Payloads(q.GetEnvelopes())
+}
+
+// GetEnvelopes is a convenience function to get all messages published to the queue along with
+// their metadata.
+func (q *Queue) GetEnvelopes() []dymant.Message {
+	result := make([]dymant.Message, 0)
 	for {
 		select {
 		case msg, ok := <-q.ch:
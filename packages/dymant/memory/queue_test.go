@@ -2,9 +2,11 @@ package memory
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.taskfleet.io/packages/dymant"
@@ -91,3 +93,63 @@ func TestPublishSubscribe(t *testing.T) {
 
 	assert.Equal(t, 10, messageCount)
 }
+
+func TestPublishSubscribeMessages(t *testing.T) {
+	queue := NewQueue(5)
+	defer queue.Close()
+
+	key := uuid.New()
+	timestamp := time.Now().Add(-time.Hour)
+	require.Nil(t, queue.PublishMessage(dymant.Message{
+		Key:       key,
+		Headers:   map[string]string{"trace-id": "abc"},
+		Timestamp: timestamp,
+		Payload:   timestamppb.Now(),
+	}))
+	require.Nil(t, queue.Publish(dymant.NoKey, timestamppb.Now()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	var received []dymant.Message
+	err := queue.ProcessMessages(ctx, func(ctx context.Context, messages []dymant.Message) error {
+		received = append(received, messages...)
+		return nil
+	})
+	assert.True(t, dymant.IsErrContext(err))
+	require.Len(t, received, 2)
+
+	assert.Equal(t, key, received[0].Key)
+	assert.Equal(t, "abc", received[0].Headers["trace-id"])
+	assert.Equal(t, timestamp, received[0].Timestamp)
+	assert.Equal(t, dymant.Position{Topic: "memory", Offset: 0}, received[0].Position)
+
+	assert.Equal(t, dymant.NoKey, received[1].Key)
+	assert.Nil(t, received[1].Headers)
+	assert.False(t, received[1].Timestamp.IsZero())
+	assert.Equal(t, int64(1), received[1].Position.Offset)
+}
+
+func TestSubscriberRetries(t *testing.T) {
+	queue := NewQueue(5, WithRetryPolicy(dymant.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}))
+	defer queue.Close()
+	require.Nil(t, queue.Publish(dymant.NoKey, timestamppb.Now()))
+	require.Nil(t, queue.Publish(dymant.NoKey, timestamppb.Now()))
+
+	// The first message succeeds on its last attempt, the second one never succeeds
+	attempts := 0
+	err := queue.Process(context.Background(),
+		func(ctx context.Context, messages []proto.Message) error {
+			attempts++
+			if attempts == 3 {
+				return nil
+			}
+			return fmt.Errorf("failed")
+		},
+	)
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 6, attempts)
+}
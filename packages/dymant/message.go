@@ -0,0 +1,44 @@
+package dymant
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Message is the envelope of a message that is published to or consumed from a message queue. In
+// addition to the actual message, it carries the message's metadata.
+type Message struct {
+	// The key of the message. Set to `NoKey` if the message was published without key.
+	Key uuid.UUID
+	// Arbitrary metadata attached to the message, e.g. trace or correlation IDs. May be nil.
+	Headers map[string]string
+	// The time at which the message was published. When publishing a message without timestamp,
+	// the current time is used.
+	Timestamp time.Time
+	// The position of the message in its message queue. Only set for consumed messages.
+	Position Position
+	// The actual message.
+	Payload proto.Message
+}
+
+// Position describes the location of a consumed message within its message queue. Consult the
+// documentation of the individual implementations for the meaning of the fields.
+type Position struct {
+	// The topic from which the message was consumed.
+	Topic string
+	// The partition of the topic from which the message was consumed.
+	Partition int32
+	// The offset of the message within its partition.
+	Offset int64
+}
+
+// Payloads returns the payloads of the given messages.
+func Payloads(messages []Message) []proto.Message {
+	result := make([]proto.Message, len(messages))
+	for i, message := range messages {
+		result[i] = message.Payload
+	}
+	return result
+}
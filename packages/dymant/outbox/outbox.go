@@ -0,0 +1,182 @@
+package outbox
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.taskfleet.io/packages/dymant"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Outbox is a durable queue of messages which are yet to be published. Messages are written to
+// the outbox within the same bbolt transaction that updates the caller's state such that either
+// both or none are persisted. A `Relay` subsequently forwards the messages to a publisher.
+type Outbox struct {
+	db     *bbolt.DB
+	bucket []byte
+	notify chan struct{}
+}
+
+// New creates an outbox which persists messages in the bucket with the given name in the
+// provided database. The bucket is created if it does not exist yet and must not be used for any
+// other purpose. A database may host multiple outboxes with different bucket names.
+func New(db *bbolt.DB, bucket string) (*Outbox, error) {
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("failed to initialize outbox bucket %q: %s", bucket, err)
+	}
+	return &Outbox{db: db, bucket: []byte(bucket), notify: make(chan struct{}, 1)}, nil
+}
+
+// Write adds the given message to the outbox as part of the provided writable transaction. The
+// message only becomes visible to relays once the transaction is committed and it is not
+// published at all if the transaction is rolled back. Messages are published in the order in
+// which they are written. If the message has no timestamp, the current time is used.
+func (o *Outbox) Write(tx *bbolt.Tx, message dymant.Message) error {
+	if !tx.Writable() {
+		return fmt.Errorf("outbox messages must be written in a writable transaction")
+	}
+	if message.Timestamp.IsZero() {
+		message.Timestamp = time.Now()
+	}
+	value, err := encodeMessage(message)
+	if err != nil {
+		return err
+	}
+
+	bucket := tx.Bucket(o.bucket)
+	sequence, err := bucket.NextSequence()
+	if err != nil {
+		return fmt.Errorf("failed to obtain outbox sequence number: %s", err)
+	}
+	if err := bucket.Put(sequenceKey(sequence), value); err != nil {
+		return fmt.Errorf("failed to write message to outbox: %s", err)
+	}
+	tx.OnCommit(o.wake)
+	return nil
+}
+
+// Pending returns the number of messages in the outbox which have not been published yet.
+func (o *Outbox) Pending() (int, error) {
+	var count int
+	err := o.db.View(func(tx *bbolt.Tx) error {
+		count = tx.Bucket(o.bucket).Stats().KeyN
+		return nil
+	})
+	return count, err
+}
+
+//-------------------------------------------------------------------------------------------------
+// RELAY ACCESS
+//-------------------------------------------------------------------------------------------------
+
+// entry is a message read from the outbox along with its key in the outbox's bucket.
+type entry struct {
+	key     []byte
+	message dymant.Message
+}
+
+// peek returns up to the given number of the oldest messages in the outbox.
+func (o *Outbox) peek(limit int) ([]entry, error) {
+	var entries []entry
+	err := o.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket(o.bucket).Cursor()
+		for k, v := cursor.First(); k != nil && len(entries) < limit; k, v = cursor.Next() {
+			message, err := decodeMessage(v)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to decode outbox message %d: %s", binary.BigEndian.Uint64(k), err,
+				)
+			}
+			// Keys are only valid for the lifetime of the transaction
+			entries = append(entries, entry{key: append([]byte{}, k...), message: message})
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// remove deletes the given entries from the outbox.
+func (o *Outbox) remove(entries []entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return o.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(o.bucket)
+		for _, entry := range entries {
+			if err := bucket.Delete(entry.key); err != nil {
+				return fmt.Errorf("failed to remove message from outbox: %s", err)
+			}
+		}
+		return nil
+	})
+}
+
+// wake notifies a waiting relay about new messages.
+func (o *Outbox) wake() {
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+}
+
+//-------------------------------------------------------------------------------------------------
+// ENCODING
+//-------------------------------------------------------------------------------------------------
+
+// record is the representation of a message in the database. The payload is stored as encoded
+// `Any` message such that its type can be restored by the relay.
+type record struct {
+	Key       uuid.UUID         `json:"key"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Timestamp time.Time         `json:"timestamp"`
+	Payload   []byte            `json:"payload"`
+}
+
+func encodeMessage(message dymant.Message) ([]byte, error) {
+	payload, err := anypb.New(message.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode payload: %s", err)
+	}
+	r := record{Key: message.Key, Headers: message.Headers, Timestamp: message.Timestamp}
+	if r.Payload, err = proto.Marshal(payload); err != nil {
+		return nil, fmt.Errorf("failed to encode payload: %s", err)
+	}
+	return json.Marshal(r)
+}
+
+func decodeMessage(data []byte) (dymant.Message, error) {
+	var r record
+	if err := json.Unmarshal(data, &r); err != nil {
+		return dymant.Message{}, fmt.Errorf("failed to decode message: %s", err)
+	}
+	var payload anypb.Any
+	if err := proto.Unmarshal(r.Payload, &payload); err != nil {
+		return dymant.Message{}, fmt.Errorf("failed to decode payload: %s", err)
+	}
+	message, err := payload.UnmarshalNew()
+	if err != nil {
+		return dymant.Message{}, fmt.Errorf("failed to decode payload: %s", err)
+	}
+	return dymant.Message{
+		Key:       r.Key,
+		Headers:   r.Headers,
+		Timestamp: r.Timestamp,
+		Payload:   message,
+	}, nil
+}
+
+// sequenceKey returns the bucket key for the given sequence number. Keys are encoded in big
+// endian such that bbolt's byte-wise ordering matches the ordering of sequence numbers.
+func sequenceKey(sequence uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+	return key
+}
@@ -0,0 +1,158 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+	"go.taskfleet.io/packages/dymant"
+	"go.taskfleet.io/packages/dymant/memory"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestOutboxWrite(t *testing.T) {
+	outbox := newOutbox(t)
+
+	// Messages of rolled back transactions are discarded
+	err := outbox.db.Update(func(tx *bbolt.Tx) error {
+		require.Nil(t, outbox.Write(tx, newMessage(uuid.New(), 0)))
+		return fmt.Errorf("failed")
+	})
+	require.Error(t, err)
+	assertPending(t, outbox, 0)
+
+	// Messages of committed transactions are persisted
+	writeMessages(t, outbox, uuid.New(), 3)
+	assertPending(t, outbox, 3)
+
+	// Writing requires a writable transaction
+	assert.Error(t, outbox.db.View(func(tx *bbolt.Tx) error {
+		return outbox.Write(tx, newMessage(uuid.New(), 0))
+	}))
+}
+
+func TestRelayForward(t *testing.T) {
+	outbox := newOutbox(t)
+	key := uuid.New()
+	writeMessages(t, outbox, key, 5)
+
+	queue := memory.NewQueue(10)
+	defer queue.Close()
+	relay := NewRelay(outbox, queue, WithBatchSize(2))
+	require.Nil(t, relay.Forward(context.Background()))
+	assertPending(t, outbox, 0)
+
+	envelopes := queue.GetEnvelopes()
+	require.Len(t, envelopes, 5)
+	for i, envelope := range envelopes {
+		assert.Equal(t, key, envelope.Key)
+		assert.Equal(t, fmt.Sprintf("%d", i), envelope.Headers["index"])
+		assert.False(t, envelope.Timestamp.IsZero())
+		assert.True(t, proto.Equal(durationpb.New(time.Duration(i)), envelope.Payload))
+	}
+}
+
+func TestRelayForwardFailure(t *testing.T) {
+	outbox := newOutbox(t)
+	writeMessages(t, outbox, uuid.New(), 5)
+
+	// Messages after the failed one remain in the outbox
+	publisher := &failingPublisher{Queue: memory.NewQueue(10), failures: map[int]bool{2: true}}
+	defer publisher.Close()
+	relay := NewRelay(outbox, publisher)
+	assert.Error(t, relay.Forward(context.Background()))
+	assertPending(t, outbox, 3)
+	assert.Len(t, publisher.GetMessages(), 2)
+
+	// Forwarding again publishes the remaining messages in order
+	require.Nil(t, relay.Forward(context.Background()))
+	assertPending(t, outbox, 0)
+	messages := publisher.GetMessages()
+	require.Len(t, messages, 3)
+	assert.True(t, proto.Equal(durationpb.New(2), messages[0]))
+}
+
+func TestRelayRun(t *testing.T) {
+	outbox := newOutbox(t)
+	queue := memory.NewQueue(10)
+	defer queue.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- NewRelay(outbox, queue, WithPollInterval(time.Hour)).Run(ctx)
+	}()
+
+	// Committed writes are forwarded without waiting for the poll interval
+	writeMessages(t, outbox, uuid.New(), 3)
+	var envelopes []dymant.Message
+	assert.Eventually(t, func() bool {
+		envelopes = append(envelopes, queue.GetEnvelopes()...)
+		return len(envelopes) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+//-------------------------------------------------------------------------------------------------
+// UTILITIES
+//-------------------------------------------------------------------------------------------------
+
+type failingPublisher struct {
+	*memory.Queue
+	count    int
+	failures map[int]bool
+}
+
+func (p *failingPublisher) PublishMessageSync(ctx context.Context, message dymant.Message) error {
+	defer func() { p.count++ }()
+	if p.failures[p.count] {
+		return fmt.Errorf("failed to publish")
+	}
+	return p.Queue.PublishMessageSync(ctx, message)
+}
+
+func newOutbox(t *testing.T) *Outbox {
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "outbox.db"), 0o600, nil)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		db.Close() // nolint:errcheck
+	})
+	outbox, err := New(db, "outbox")
+	require.Nil(t, err)
+	return outbox
+}
+
+func newMessage(key uuid.UUID, index int) dymant.Message {
+	return dymant.Message{
+		Key:     key,
+		Headers: map[string]string{"index": fmt.Sprintf("%d", index)},
+		Payload: durationpb.New(time.Duration(index)),
+	}
+}
+
+func writeMessages(t *testing.T, outbox *Outbox, key uuid.UUID, n int) {
+	require.Nil(t, outbox.db.Update(func(tx *bbolt.Tx) error {
+		for i := 0; i < n; i++ {
+			if err := outbox.Write(tx, newMessage(key, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+func assertPending(t *testing.T, outbox *Outbox, expected int) {
+	pending, err := outbox.Pending()
+	require.Nil(t, err)
+	assert.Equal(t, expected, pending)
+}
@@ -0,0 +1,96 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.taskfleet.io/packages/dymant"
+	"go.uber.org/zap"
+)
+
+// Relay forwards the messages of an outbox to a publisher. Messages are published with
+// at-least-once semantics: a message is removed from the outbox only after the publisher
+// confirmed it, i.e. messages may be published multiple times if the relay fails in between.
+// Messages are published one after the other in the order in which they were written, hence, the
+// order of messages with the same key is retained. For the same reason, at most one relay must
+// run for an outbox. The relay implements the `mercury.Runnable` interface.
+type Relay struct {
+	outbox       *Outbox
+	publisher    dymant.Publisher
+	logger       *zap.Logger
+	batchSize    int
+	pollInterval time.Duration
+}
+
+// NewRelay creates a new relay which forwards the messages of the given outbox to the provided
+// publisher.
+func NewRelay(outbox *Outbox, publisher dymant.Publisher, options ...RelayOption) *Relay {
+	relay := &Relay{
+		outbox:       outbox,
+		publisher:    publisher,
+		logger:       zap.NewNop(),
+		batchSize:    100,
+		pollInterval: 5 * time.Second,
+	}
+	for _, option := range options {
+		option.apply(relay)
+	}
+	return relay
+}
+
+// Run forwards messages as soon as they are written to the outbox until the context is
+// cancelled. Failures are logged and forwarding is retried after the poll interval, i.e. they do
+// not cause the relay to exit.
+func (r *Relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Forward(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Error("failed to forward outbox messages", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.outbox.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Forward publishes all messages which are currently in the outbox. If publishing a message
+// fails, forwarding stops and the message as well as all subsequent messages remain in the
+// outbox.
+func (r *Relay) Forward(ctx context.Context) error {
+	for {
+		entries, err := r.outbox.peek(r.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		published := 0
+		var publishErr error
+		for _, entry := range entries {
+			if err := r.publisher.PublishMessageSync(ctx, entry.message); err != nil {
+				publishErr = fmt.Errorf("failed to publish outbox message: %s", err)
+				break
+			}
+			published++
+		}
+
+		// Published messages must be removed even if publishing a subsequent message failed
+		if err := r.outbox.remove(entries[:published]); err != nil {
+			return err
+		}
+		if publishErr != nil {
+			return publishErr
+		}
+		if len(entries) < r.batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RelayOption allows to customize an outbox relay.
+type RelayOption interface {
+	apply(r *Relay)
+}
+
+//-------------------------------------------------------------------------------------------------
+// BATCH SIZE
+//-------------------------------------------------------------------------------------------------
+
+type relayOptionBatchSize struct {
+	size int
+}
+
+// WithBatchSize sets the maximum number of messages that are read from the outbox at once. The
+// messages of a batch are removed from the outbox in a single transaction after publishing them.
+// If this option is not set, batches contain up to 100 messages.
+func WithBatchSize(size int) RelayOption {
+	return relayOptionBatchSize{size}
+}
+
+func (o relayOptionBatchSize) apply(r *Relay) {
+	if o.size > 0 {
+		r.batchSize = o.size
+	}
+}
+
+//-------------------------------------------------------------------------------------------------
+// POLL INTERVAL
+//-------------------------------------------------------------------------------------------------
+
+type relayOptionPollInterval struct {
+	interval time.Duration
+}
+
+// WithPollInterval sets the interval at which the relay checks the outbox for messages in
+// addition to being notified about committed writes. Most importantly, this interval determines
+// how quickly the relay retries after publishing failed. If this option is not set, the outbox is
+// polled every five seconds.
+func WithPollInterval(interval time.Duration) RelayOption {
+	return relayOptionPollInterval{interval}
+}
+
+func (o relayOptionPollInterval) apply(r *Relay) {
+	if o.interval > 0 {
+		r.pollInterval = o.interval
+	}
+}
+
+//-------------------------------------------------------------------------------------------------
+// LOGGER
+//-------------------------------------------------------------------------------------------------
+
+type relayOptionLogger struct {
+	logger *zap.Logger
+}
+
+// WithLogger sets the logger used to report failures. If this option is not set, nothing is
+// logged.
+func WithLogger(logger *zap.Logger) RelayOption {
+	return relayOptionLogger{logger}
+}
+
+func (o relayOptionLogger) apply(r *Relay) {
+	if o.logger != nil {
+		r.logger = o.logger
+	}
+}
@@ -0,0 +1,59 @@
+package dymant
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy describes how subscribers re-deliver messages in-process if processing them fails.
+type RetryPolicy struct {
+	// The maximum number of times that a batch of messages is delivered, including the first
+	// delivery. Values smaller than two disable retries.
+	MaxAttempts int
+	// The backoff before the first retry, doubled for every subsequent retry. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// The upper bound for the backoff between retries. Defaults to 10s.
+	MaxBackoff time.Duration
+	// An optional predicate whether processing should be retried after the given error. If not
+	// set, all errors are retried.
+	Retryable func(err error) bool
+}
+
+// Execute runs the given attempt until it succeeds, the maximum number of attempts is reached,
+// it returns an error that is not retryable, or the context is cancelled. In the latter cases,
+// the error of the last attempt is returned. The actual backoff between attempts is chosen
+// randomly between half of and the full exponential backoff.
+func (p RetryPolicy) Execute(ctx context.Context, attempt func(context.Context) error) error {
+	backoff := p.InitialBackoff
+	if backoff <= 0 {
+		backoff = 100 * time.Millisecond
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 10 * time.Second
+	}
+
+	for i := 1; ; i++ {
+		err := attempt(ctx)
+		if err == nil || i >= p.MaxAttempts || ctx.Err() != nil {
+			return err
+		}
+		if p.Retryable != nil && !p.Retryable(err) {
+			return err
+		}
+
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+		jittered := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		timer := time.NewTimer(jittered)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,81 @@
+package dymant_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.taskfleet.io/packages/dymant"
+)
+
+var errFailed = errors.New("failed")
+
+func TestRetryPolicyExecute(t *testing.T) {
+	policy := dymant.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	// Success after retries
+	attempts := 0
+	err := policy.Execute(context.Background(), func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errFailed
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+
+	// Failure after exhausting attempts
+	attempts = 0
+	err = policy.Execute(context.Background(), func(ctx context.Context) error {
+		attempts++
+		return errFailed
+	})
+	assert.ErrorIs(t, err, errFailed)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryPolicyExecuteNoRetries(t *testing.T) {
+	attempts := 0
+	err := dymant.RetryPolicy{}.Execute(context.Background(), func(ctx context.Context) error {
+		attempts++
+		return errFailed
+	})
+	assert.ErrorIs(t, err, errFailed)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicyExecuteNotRetryable(t *testing.T) {
+	policy := dymant.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Retryable: func(err error) bool {
+			return !errors.Is(err, errFailed)
+		},
+	}
+	attempts := 0
+	err := policy.Execute(context.Background(), func(ctx context.Context) error {
+		attempts++
+		return errFailed
+	})
+	assert.ErrorIs(t, err, errFailed)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicyExecuteCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	policy := dymant.RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second}
+	attempts := 0
+	start := time.Now()
+	err := policy.Execute(ctx, func(ctx context.Context) error {
+		attempts++
+		return errFailed
+	})
+	assert.ErrorIs(t, err, errFailed)
+	assert.Equal(t, 1, attempts)
+	assert.Less(t, time.Since(start), time.Second/2)
+}
@@ -0,0 +1,88 @@
+package dymant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// TypedPublisher wraps a publisher such that only messages of a single type can be published.
+type TypedPublisher[T proto.Message] struct {
+	publisher Publisher
+}
+
+// NewTypedPublisher wraps the given publisher to publish messages of type T.
+func NewTypedPublisher[T proto.Message](publisher Publisher) TypedPublisher[T] {
+	return TypedPublisher[T]{publisher}
+}
+
+// Publish publishes the given message without waiting for confirmation, see `Publisher.Publish`.
+func (p TypedPublisher[T]) Publish(key uuid.UUID, message T) error {
+	return p.publisher.Publish(key, message)
+}
+
+// PublishSync publishes the given message and waits for confirmation, see
+// `Publisher.PublishSync`.
+func (p TypedPublisher[T]) PublishSync(ctx context.Context, key uuid.UUID, message T) error {
+	return p.publisher.PublishSync(ctx, key, message)
+}
+
+// Flush waits for all messages to be delivered, see `Publisher.Flush`.
+func (p TypedPublisher[T]) Flush(ctx context.Context) error {
+	return p.publisher.Flush(ctx)
+}
+
+// Unwrap returns the underlying publisher.
+func (p TypedPublisher[T]) Unwrap() Publisher {
+	return p.publisher
+}
+
+//-------------------------------------------------------------------------------------------------
+
+// TypedSubscriber wraps a subscriber such that consumed messages are delivered with their actual
+// type T. The wrapped subscriber must deliver messages of type T.
+type TypedSubscriber[T proto.Message] struct {
+	subscriber Subscriber
+}
+
+// NewTypedSubscriber wraps the given subscriber to deliver messages of type T.
+func NewTypedSubscriber[T proto.Message](subscriber Subscriber) TypedSubscriber[T] {
+	return TypedSubscriber[T]{subscriber}
+}
+
+// Process consumes messages from the subscriber's message queue, see `Subscriber.Process`. If the
+// subscriber delivers a message which is not of type T, processing fails.
+func (s TypedSubscriber[T]) Process(
+	ctx context.Context, execute func(context.Context, []T) error,
+) error {
+	return s.subscriber.Process(ctx, func(ctx context.Context, messages []proto.Message) error {
+		typed := make([]T, len(messages))
+		for i, message := range messages {
+			t, ok := message.(T)
+			if !ok {
+				return fmt.Errorf("received message of type %T, expected %T", message, t)
+			}
+			typed[i] = t
+		}
+		return execute(ctx, typed)
+	})
+}
+
+// Close ensures that the subscriber is properly cleaned up, see `Subscriber.Close`.
+func (s TypedSubscriber[T]) Close() {
+	s.subscriber.Close()
+}
+
+// Unwrap returns the underlying subscriber.
+func (s TypedSubscriber[T]) Unwrap() Subscriber {
+	return s.subscriber
+}
+
+// MessageTemplate returns an empty message of type T, e.g. to pass it to implementations which
+// require a template message for unmarshaling.
+func MessageTemplate[T proto.Message]() T {
+	var zero T
+	return zero.ProtoReflect().Type().New().Interface().(T)
+}
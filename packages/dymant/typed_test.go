@@ -0,0 +1,62 @@
+package dymant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.taskfleet.io/packages/dymant"
+	"go.taskfleet.io/packages/dymant/memory"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTypedPublishSubscribe(t *testing.T) {
+	queue := memory.NewQueue(10)
+	defer queue.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	publisher := dymant.NewTypedPublisher[*timestamppb.Timestamp](queue)
+	now := timestamppb.Now()
+	for i := 0; i < 3; i++ {
+		require.Nil(t, publisher.PublishSync(ctx, dymant.NoKey, now))
+	}
+	require.Nil(t, publisher.Flush(ctx))
+
+	var received []*timestamppb.Timestamp
+	subscriber := dymant.NewTypedSubscriber[*timestamppb.Timestamp](queue)
+	err := subscriber.Process(ctx,
+		func(ctx context.Context, messages []*timestamppb.Timestamp) error {
+			received = append(received, messages...)
+			return nil
+		},
+	)
+	assert.True(t, dymant.IsErrContext(err))
+	require.Len(t, received, 3)
+	assert.True(t, proto.Equal(now, received[0]))
+}
+
+func TestTypedSubscriberUnexpectedType(t *testing.T) {
+	queue := memory.NewQueue(10)
+	defer queue.Close()
+	queue.SetMessages([]proto.Message{durationpb.New(time.Second)})
+
+	subscriber := dymant.NewTypedSubscriber[*timestamppb.Timestamp](queue)
+	err := subscriber.Process(context.Background(),
+		func(ctx context.Context, messages []*timestamppb.Timestamp) error {
+			return nil
+		},
+	)
+	assert.ErrorContains(t, err, "expected *timestamppb.Timestamp")
+}
+
+func TestMessageTemplate(t *testing.T) {
+	template := dymant.MessageTemplate[*timestamppb.Timestamp]()
+	require.NotNil(t, template)
+	assert.True(t, proto.Equal(&timestamppb.Timestamp{}, template))
+}
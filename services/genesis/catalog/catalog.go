@@ -0,0 +1,220 @@
+package catalog
+
+import (
+	"fmt"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/eagle"
+)
+
+// Catalog describes the instance types and zones offered by cloud providers. It is immutable and,
+// thus, safe for concurrent use.
+type Catalog struct {
+	instanceTypes map[genesis.CloudProvider][]InstanceType
+	zones         map[genesis.CloudProvider][]ZoneConfig
+}
+
+// Config describes the contents of a catalog file.
+type Config struct {
+	// The cloud providers described by the catalog.
+	Providers []ProviderConfig `json:"providers"`
+}
+
+// ProviderConfig describes the instance types of a single cloud provider.
+type ProviderConfig struct {
+	// The cloud provider, e.g. `CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM`.
+	CloudProvider CloudProvider `json:"cloudProvider"`
+	// The instance types offered by the cloud provider.
+	InstanceTypes []InstanceType `json:"instanceTypes"`
+	// Zones of the cloud provider. These zones are merged with the zones discovered from the
+	// provider, see `ZoneCatalog`.
+	Zones []ZoneConfig `json:"zones"`
+}
+
+// InstanceType describes a machine type that is offered by a cloud provider.
+type InstanceType struct {
+	// The provider-specific name of the machine type.
+	Name string `json:"name"`
+	// The number of CPUs of the machine type.
+	CPUCount uint32 `json:"cpus"`
+	// The amount of memory of the machine type in megabytes.
+	Memory uint32 `json:"memory"`
+	// The GPUs attached to the machine type, if any.
+	GPU *GPU `json:"gpu,omitempty"`
+	// Whether the machine type belongs to a compute-optimized family.
+	ComputeOptimized bool `json:"computeOptimized"`
+	// The zones in which the machine type is available. If empty, it is available in all zones.
+	Zones []string `json:"zones,omitempty"`
+	// The hourly price of the machine type.
+	Price Price `json:"price"`
+}
+
+// GPU describes the GPUs attached to an instance type.
+type GPU struct {
+	// The kind of GPU, e.g. `GPU_KIND_TESLA_T4`.
+	Kind GPUKind `json:"kind"`
+	// The number of attached GPUs.
+	Count uint32 `json:"count"`
+}
+
+// Price describes the hourly price of an instance type in an arbitrary but consistent currency.
+type Price struct {
+	// The price of regular instances.
+	OnDemand float64 `json:"onDemand"`
+	// The price of spot instances. If zero, spot instances are assumed to cost the same as
+	// on-demand instances.
+	Spot float64 `json:"spot,omitempty"`
+	// Prices in individual zones which deviate from the prices above, keyed by zone name.
+	Zones map[string]ZonePrice `json:"zones,omitempty"`
+}
+
+// ZonePrice describes the hourly price of an instance type in a single zone.
+type ZonePrice struct {
+	// The price of regular instances.
+	OnDemand float64 `json:"onDemand"`
+	// The price of spot instances. If zero, spot instances are assumed to cost the same as
+	// on-demand instances in the zone.
+	Spot float64 `json:"spot,omitempty"`
+}
+
+// LoadCatalog loads the catalog from the given sources (e.g. `eagle.WithYAMLFile`) and validates
+// it.
+func LoadCatalog(sources ...eagle.ConfigSource) (*Catalog, error) {
+	var config Config
+	if err := eagle.LoadConfig(&config, sources...); err != nil {
+		return nil, fmt.Errorf("failed to load catalog: %s", err)
+	}
+	return NewCatalog(config)
+}
+
+// NewCatalog creates a new catalog from the given configuration. It returns an error if the
+// configuration is invalid.
+func NewCatalog(config Config) (*Catalog, error) {
+	c := &Catalog{
+		instanceTypes: map[genesis.CloudProvider][]InstanceType{},
+		zones:         map[genesis.CloudProvider][]ZoneConfig{},
+	}
+	for _, p := range config.Providers {
+		cloud := genesis.CloudProvider(p.CloudProvider)
+		if cloud == genesis.CloudProvider_CLOUD_PROVIDER_UNSPECIFIED {
+			return nil, fmt.Errorf("catalog contains provider without cloud provider")
+		}
+		if _, ok := c.instanceTypes[cloud]; ok {
+			return nil, fmt.Errorf("catalog contains %s multiple times", cloud)
+		}
+
+		names := map[string]struct{}{}
+		for _, instanceType := range p.InstanceTypes {
+			if err := instanceType.validate(); err != nil {
+				return nil, fmt.Errorf("invalid instance type %q of %s: %s",
+					instanceType.Name, cloud, err,
+				)
+			}
+			if _, ok := names[instanceType.Name]; ok {
+				return nil, fmt.Errorf("instance type %q of %s exists multiple times",
+					instanceType.Name, cloud,
+				)
+			}
+			names[instanceType.Name] = struct{}{}
+		}
+		c.instanceTypes[cloud] = p.InstanceTypes
+
+		zoneNames := map[string]struct{}{}
+		for _, zone := range p.Zones {
+			if zone.Name == "" {
+				return nil, fmt.Errorf("zone of %s has no name", cloud)
+			}
+			if _, ok := zoneNames[zone.Name]; ok {
+				return nil, fmt.Errorf("zone %q of %s exists multiple times", zone.Name, cloud)
+			}
+			zoneNames[zone.Name] = struct{}{}
+		}
+		c.zones[cloud] = p.Zones
+	}
+	return c, nil
+}
+
+// InstanceType returns the instance type of the given cloud provider with the specified name.
+func (c *Catalog) InstanceType(
+	cloud genesis.CloudProvider, name string,
+) (InstanceType, bool) {
+	for _, instanceType := range c.instanceTypes[cloud] {
+		if instanceType.Name == name {
+			return instanceType, true
+		}
+	}
+	return InstanceType{}, false
+}
+
+//-------------------------------------------------------------------------------------------------
+// INSTANCE TYPES
+//-------------------------------------------------------------------------------------------------
+
+// Resources returns the resources that are available on instances of this type.
+func (t InstanceType) Resources() *genesis.InstanceResources {
+	resources := &genesis.InstanceResources{
+		CpuCount: t.CPUCount,
+		Memory:   t.Memory,
+	}
+	if t.GPU != nil {
+		resources.Gpu = &genesis.GPUResources{
+			Kind:  genesis.GPUKind(t.GPU.Kind),
+			Count: t.GPU.Count,
+		}
+	}
+	return resources
+}
+
+// HourlyPrice returns the hourly price of an instance of this type in the given zone.
+func (t InstanceType) HourlyPrice(zone string, isSpot bool) float64 {
+	price := ZonePrice{OnDemand: t.Price.OnDemand, Spot: t.Price.Spot}
+	if zonePrice, ok := t.Price.Zones[zone]; ok {
+		price = zonePrice
+	}
+	if isSpot && price.Spot > 0 {
+		return price.Spot
+	}
+	return price.OnDemand
+}
+
+// AvailableIn returns whether the instance type is available in the given zone.
+func (t InstanceType) AvailableIn(zone string) bool {
+	if len(t.Zones) == 0 {
+		return true
+	}
+	for _, z := range t.Zones {
+		if z == zone {
+			return true
+		}
+	}
+	return false
+}
+
+func (t InstanceType) validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("name must be set")
+	}
+	if t.CPUCount == 0 {
+		return fmt.Errorf("number of CPUs must be positive")
+	}
+	if t.Memory == 0 {
+		return fmt.Errorf("memory must be positive")
+	}
+	if t.GPU != nil {
+		if genesis.GPUKind(t.GPU.Kind) == genesis.GPUKind_GPU_KIND_UNSPECIFIED {
+			return fmt.Errorf("GPU kind must be set")
+		}
+		if t.GPU.Count == 0 {
+			return fmt.Errorf("number of GPUs must be positive")
+		}
+	}
+	if t.Price.OnDemand < 0 || t.Price.Spot < 0 {
+		return fmt.Errorf("prices must not be negative")
+	}
+	for zone, price := range t.Price.Zones {
+		if price.OnDemand < 0 || price.Spot < 0 {
+			return fmt.Errorf("prices in zone %q must not be negative", zone)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package catalog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/eagle"
+)
+
+func TestLoadCatalog(t *testing.T) {
+	catalog, err := LoadCatalog(eagle.WithYAMLFile("testdata/catalog.yaml", false))
+	require.Nil(t, err)
+
+	instanceType, ok := catalog.InstanceType(
+		genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM, "n1-standard-4-t4",
+	)
+	require.True(t, ok)
+	assert.Equal(t, uint32(4), instanceType.CPUCount)
+	assert.Equal(t, uint32(15360), instanceType.Memory)
+	assert.Equal(t, &GPU{Kind: GPUKind(genesis.GPUKind_GPU_KIND_TESLA_T4), Count: 1},
+		instanceType.GPU,
+	)
+	assert.Equal(t, []string{"europe-west1-b"}, instanceType.Zones)
+	assert.Equal(t, 0.17, instanceType.HourlyPrice("europe-west1-b", true))
+	assert.Equal(t, 0.54, instanceType.HourlyPrice("europe-west1-b", false))
+
+	// Zone-specific prices must take precedence
+	instanceType, ok = catalog.InstanceType(
+		genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM, "n2-standard-8",
+	)
+	require.True(t, ok)
+	assert.Equal(t, 0.094, instanceType.HourlyPrice("europe-west1-b", true))
+	assert.Equal(t, 0.412, instanceType.HourlyPrice("us-east1-c", false))
+	assert.Equal(t, 0.412, instanceType.HourlyPrice("us-east1-c", true))
+
+	_, ok = catalog.InstanceType(
+		genesis.CloudProvider_CLOUD_PROVIDER_AMAZON_WEB_SERVICES, "n1-standard-4-t4",
+	)
+	assert.False(t, ok)
+
+	zones := catalog.zones[genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM]
+	assert.Equal(t, []ZoneConfig{
+		{Name: "europe-west1-b", GPUs: []GPUKind{GPUKind(genesis.GPUKind_GPU_KIND_TESLA_T4)}},
+		{Name: "europe-west4-a", Disabled: true},
+	}, zones)
+}
+
+func TestNewCatalogInvalid(t *testing.T) {
+	gcp := CloudProvider(genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM)
+	valid := InstanceType{Name: "n2-standard-4", CPUCount: 4, Memory: 16384}
+
+	configs := map[string]Config{
+		"missing cloud provider": {Providers: []ProviderConfig{{}}},
+		"duplicate cloud provider": {Providers: []ProviderConfig{
+			{CloudProvider: gcp}, {CloudProvider: gcp},
+		}},
+		"duplicate instance type": {Providers: []ProviderConfig{
+			{CloudProvider: gcp, InstanceTypes: []InstanceType{valid, valid}},
+		}},
+		"missing CPUs": {Providers: []ProviderConfig{
+			{CloudProvider: gcp, InstanceTypes: []InstanceType{{Name: "n2", Memory: 1024}}},
+		}},
+		"missing GPU kind": {Providers: []ProviderConfig{
+			{CloudProvider: gcp, InstanceTypes: []InstanceType{{
+				Name: "n1", CPUCount: 4, Memory: 1024, GPU: &GPU{Count: 1},
+			}}},
+		}},
+		"negative zone price": {Providers: []ProviderConfig{
+			{CloudProvider: gcp, InstanceTypes: []InstanceType{{
+				Name: "n2", CPUCount: 4, Memory: 1024, Price: Price{
+					Zones: map[string]ZonePrice{"us-east1-c": {OnDemand: -1}},
+				},
+			}}},
+		}},
+	}
+	for name, config := range configs {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewCatalog(config)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestUnmarshalEnum(t *testing.T) {
+	var kind GPUKind
+	require.Nil(t, kind.UnmarshalJSON([]byte(`"GPU_KIND_TESLA_A100"`)))
+	assert.Equal(t, GPUKind(genesis.GPUKind_GPU_KIND_TESLA_A100), kind)
+	assert.NotNil(t, kind.UnmarshalJSON([]byte(`"GPU_KIND_UNKNOWN"`)))
+
+	var gpus map[GPUKind]uint32
+	require.Nil(t, json.Unmarshal([]byte(`{"GPU_KIND_TESLA_T4": 2}`), &gpus))
+	assert.Equal(t, map[GPUKind]uint32{GPUKind(genesis.GPUKind_GPU_KIND_TESLA_T4): 2}, gpus)
+	assert.NotNil(t, json.Unmarshal([]byte(`{"TESLA_T4": 2}`), &gpus))
+
+	var cloud CloudProvider
+	require.Nil(t, cloud.UnmarshalJSON([]byte(`"CLOUD_PROVIDER_AMAZON_WEB_SERVICES"`)))
+	assert.Equal(t, CloudProvider(genesis.CloudProvider_CLOUD_PROVIDER_AMAZON_WEB_SERVICES), cloud)
+	assert.NotNil(t, cloud.UnmarshalJSON([]byte(`1`)))
+}
@@ -0,0 +1,76 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+)
+
+// CloudProvider is a cloud provider which can be decoded from its enum value name.
+type CloudProvider genesis.CloudProvider
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (c *CloudProvider) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, genesis.CloudProvider_value)
+	if err != nil {
+		return err
+	}
+	*c = CloudProvider(value)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c CloudProvider) MarshalJSON() ([]byte, error) {
+	return json.Marshal(genesis.CloudProvider(c).String())
+}
+
+// GPUKind is a GPU kind which can be decoded from its enum value name.
+type GPUKind genesis.GPUKind
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (k *GPUKind) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, genesis.GPUKind_value)
+	if err != nil {
+		return err
+	}
+	*k = GPUKind(value)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (k GPUKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(genesis.GPUKind(k).String())
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It allows GPU kinds to be used
+// as keys of JSON objects.
+func (k *GPUKind) UnmarshalText(text []byte) error {
+	value, err := parseEnum(string(text), genesis.GPUKind_value)
+	if err != nil {
+		return err
+	}
+	*k = GPUKind(value)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (k GPUKind) MarshalText() ([]byte, error) {
+	return []byte(genesis.GPUKind(k).String()), nil
+}
+
+func unmarshalEnum(data []byte, values map[string]int32) (int32, error) {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return 0, err
+	}
+	return parseEnum(name, values)
+}
+
+func parseEnum(name string, values map[string]int32) (int32, error) {
+	value, ok := values[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown enum value %q", name)
+	}
+	return value, nil
+}
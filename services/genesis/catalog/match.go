@@ -0,0 +1,97 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+)
+
+// ErrNoMatch is returned if no instance type satisfies the requested resources.
+var ErrNoMatch = errors.New("no matching instance type")
+
+// Request describes the requirements for which an instance type ought to be found.
+type Request struct {
+	// The cloud provider in which to create the instance.
+	CloudProvider genesis.CloudProvider
+	// The zone in which to create the instance.
+	Zone string
+	// Whether the instance is created as spot instance.
+	IsSpot bool
+	// The minimum resources that the instance must provide.
+	Resources *genesis.InstanceResources
+	// Whether compute-optimized instance types should be preferred.
+	PreferHPC bool
+}
+
+// Match returns the cheapest instance type which is available in the requested zone and provides
+// at least the requested resources. Instance types with GPUs are only considered if GPUs are
+// requested. If compute-optimized instance types are preferred, they are chosen over cheaper
+// instance types whenever one of them satisfies the request. If no instance type satisfies the
+// request, the returned error wraps `ErrNoMatch`.
+func (c *Catalog) Match(req Request) (InstanceType, error) {
+	candidates := []InstanceType{}
+	hpcAvailable := false
+	for _, instanceType := range c.instanceTypes[req.CloudProvider] {
+		if instanceType.AvailableIn(req.Zone) && instanceType.satisfies(req.Resources) {
+			candidates = append(candidates, instanceType)
+			hpcAvailable = hpcAvailable || instanceType.ComputeOptimized
+		}
+	}
+	if req.PreferHPC && hpcAvailable {
+		filtered := candidates[:0]
+		for _, instanceType := range candidates {
+			if instanceType.ComputeOptimized {
+				filtered = append(filtered, instanceType)
+			}
+		}
+		candidates = filtered
+	}
+	if len(candidates) == 0 {
+		return InstanceType{}, fmt.Errorf(
+			"%w for %d CPUs, %d MB memory and %s in zone %q of %s", ErrNoMatch,
+			req.Resources.CpuCount, req.Resources.Memory, describeGPU(req.Resources.Gpu),
+			req.Zone, req.CloudProvider,
+		)
+	}
+
+	// Choose the cheapest instance type, preferring fewer resources and, finally, the name to
+	// obtain a deterministic result
+	sort.Slice(candidates, func(i, j int) bool {
+		left, right := candidates[i], candidates[j]
+		lp, rp := left.HourlyPrice(req.Zone, req.IsSpot), right.HourlyPrice(req.Zone, req.IsSpot)
+		if lp != rp {
+			return lp < rp
+		}
+		if left.CPUCount != right.CPUCount {
+			return left.CPUCount < right.CPUCount
+		}
+		if left.Memory != right.Memory {
+			return left.Memory < right.Memory
+		}
+		return left.Name < right.Name
+	})
+	return candidates[0], nil
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func (t InstanceType) satisfies(resources *genesis.InstanceResources) bool {
+	if t.CPUCount < resources.CpuCount || t.Memory < resources.Memory {
+		return false
+	}
+	if resources.Gpu == nil {
+		return t.GPU == nil
+	}
+	return t.GPU != nil &&
+		genesis.GPUKind(t.GPU.Kind) == resources.Gpu.Kind &&
+		t.GPU.Count >= resources.Gpu.Count
+}
+
+func describeGPU(gpu *genesis.GPUResources) string {
+	if gpu == nil {
+		return "no GPUs"
+	}
+	return fmt.Sprintf("%dx %s", gpu.Count, gpu.Kind)
+}
@@ -0,0 +1,103 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/eagle"
+)
+
+func TestMatch(t *testing.T) {
+	catalog, err := LoadCatalog(eagle.WithYAMLFile("testdata/catalog.yaml", false))
+	require.Nil(t, err)
+
+	gcp := genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM
+	cpu := &genesis.InstanceResources{CpuCount: 4, Memory: 8192}
+	gpu := &genesis.InstanceResources{CpuCount: 2, Memory: 8192, Gpu: &genesis.GPUResources{
+		Kind: genesis.GPUKind_GPU_KIND_TESLA_T4, Count: 1,
+	}}
+
+	testCases := []struct {
+		name     string
+		request  Request
+		expected string
+	}{{
+		name:     "cheapest",
+		request:  Request{CloudProvider: gcp, Zone: "europe-west1-b", Resources: cpu},
+		expected: "n2-standard-4",
+	}, {
+		name:     "zone restriction",
+		request:  Request{CloudProvider: gcp, Zone: "us-east1-c", Resources: cpu},
+		expected: "e2-standard-4",
+	}, {
+		name: "spot price",
+		request: Request{
+			CloudProvider: gcp, Zone: "us-east1-c", Resources: cpu, IsSpot: true,
+		},
+		expected: "n2-standard-4",
+	}, {
+		name: "larger instance",
+		request: Request{CloudProvider: gcp, Zone: "europe-west1-b",
+			Resources: &genesis.InstanceResources{CpuCount: 6, Memory: 8192},
+		},
+		expected: "n2-standard-8",
+	}, {
+		name: "prefer hpc",
+		request: Request{
+			CloudProvider: gcp, Zone: "europe-west1-b", Resources: cpu, PreferHPC: true,
+		},
+		expected: "c2-standard-4",
+	}, {
+		name: "prefer hpc without compute-optimized match",
+		request: Request{CloudProvider: gcp, Zone: "europe-west1-b", PreferHPC: true,
+			Resources: &genesis.InstanceResources{CpuCount: 8, Memory: 8192},
+		},
+		expected: "n2-standard-8",
+	}, {
+		name:     "gpu",
+		request:  Request{CloudProvider: gcp, Zone: "europe-west1-b", Resources: gpu},
+		expected: "n1-standard-4-t4",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			instanceType, err := catalog.Match(tc.request)
+			require.Nil(t, err)
+			assert.Equal(t, tc.expected, instanceType.Name)
+		})
+	}
+}
+
+func TestMatchNone(t *testing.T) {
+	catalog, err := LoadCatalog(eagle.WithYAMLFile("testdata/catalog.yaml", false))
+	require.Nil(t, err)
+
+	gpu := &genesis.InstanceResources{CpuCount: 2, Memory: 8192, Gpu: &genesis.GPUResources{
+		Kind: genesis.GPUKind_GPU_KIND_TESLA_T4, Count: 1,
+	}}
+	requests := map[string]Request{
+		"gpu in unavailable zone": {
+			CloudProvider: genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+			Zone:          "us-east1-c",
+			Resources:     gpu,
+		},
+		"too many cpus": {
+			CloudProvider: genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+			Zone:          "europe-west1-b",
+			Resources:     &genesis.InstanceResources{CpuCount: 64, Memory: 8192},
+		},
+		"unknown cloud provider": {
+			CloudProvider: genesis.CloudProvider_CLOUD_PROVIDER_AMAZON_WEB_SERVICES,
+			Zone:          "us-east-1a",
+			Resources:     &genesis.InstanceResources{CpuCount: 1, Memory: 1024},
+		},
+	}
+	for name, request := range requests {
+		t.Run(name, func(t *testing.T) {
+			_, err := catalog.Match(request)
+			assert.ErrorIs(t, err, ErrNoMatch)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/singleflight"
+)
+
+// staleRetryInterval is the maximum duration for which stale zones are returned after discovery
+// failed before discovery is attempted again.
+const staleRetryInterval = 10 * time.Second
+
+// ZoneConfig statically describes a zone of a cloud provider.
+type ZoneConfig struct {
+	// The provider-specific name of the zone.
+	Name string `json:"name"`
+	// GPUs which are available in the zone in addition to the ones discovered from the provider.
+	GPUs []GPUKind `json:"gpus,omitempty"`
+	// Whether the zone is disabled. Disabled zones are never returned, even if they are
+	// discovered from the provider.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// Zone describes a zone in which instances can be created.
+type Zone struct {
+	// The cloud provider to which the zone belongs.
+	CloudProvider genesis.CloudProvider
+	// The provider-specific name of the zone.
+	Name string
+	// The kinds of GPUs that are available in the zone, in ascending order.
+	GPUs []genesis.GPUKind
+}
+
+// ZoneCatalog provides the zones of all enabled cloud providers, i.e. the cloud providers for
+// which a provider exists. Zones are obtained by merging the zones discovered from the providers
+// with the zones configured statically in a catalog. As discovering zones might be expensive, the
+// zones are cached for a configurable duration and concurrent discoveries are deduplicated. The
+// zone catalog is safe for concurrent use.
+type ZoneCatalog struct {
+	providers []provider.Provider
+	catalog   *Catalog
+	ttl       time.Duration
+	discovery singleflight.Group
+
+	mutex     sync.Mutex
+	zones     []Zone
+	expiresAt time.Time
+}
+
+// NewZoneCatalog creates a new zone catalog for the given providers. The catalog may be `nil` if
+// zones should only be discovered from the providers. Discovered zones are cached for the given
+// duration.
+func NewZoneCatalog(
+	providers []provider.Provider, catalog *Catalog, ttl time.Duration,
+) *ZoneCatalog {
+	sorted := slices.Clone(providers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].CloudProvider() < sorted[j].CloudProvider()
+	})
+	return &ZoneCatalog{providers: sorted, catalog: catalog, ttl: ttl}
+}
+
+// Zones returns all zones, ordered by their cloud provider and name. If the zones of a provider
+// cannot be discovered, the most recently discovered zones are returned and discovery is only
+// attempted again after a short backoff. If zones have never been discovered, an error is
+// returned.
+func (c *ZoneCatalog) Zones(ctx context.Context) ([]Zone, error) {
+	c.mutex.Lock()
+	zones, expiresAt := c.zones, c.expiresAt
+	c.mutex.Unlock()
+	if zones != nil && time.Now().Before(expiresAt) {
+		return zones, nil
+	}
+
+	// Providers are queried without holding the lock such that callers are not blocked by slow
+	// providers while cached zones are available. Concurrent refreshes share a single discovery
+	// which uses the context of the first caller.
+	result, err, _ := c.discovery.Do("", func() (any, error) {
+		return c.refresh(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]Zone), nil
+}
+
+// Zone returns the zone with the specified name of the given cloud provider. The boolean flag
+// indicates whether the zone exists.
+func (c *ZoneCatalog) Zone(
+	ctx context.Context, cloud genesis.CloudProvider, name string,
+) (Zone, bool, error) {
+	zones, err := c.Zones(ctx)
+	if err != nil {
+		return Zone{}, false, err
+	}
+	for _, zone := range zones {
+		if zone.CloudProvider == cloud && zone.Name == name {
+			return zone, true, nil
+		}
+	}
+	return Zone{}, false, nil
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func (c *ZoneCatalog) refresh(ctx context.Context) ([]Zone, error) {
+	zones, err := c.discover(ctx)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err != nil {
+		if c.zones == nil {
+			return nil, err
+		}
+		zeus.Logger(ctx).Warn("failed to discover zones, using stale zones", zap.Error(err))
+		backoff := staleRetryInterval
+		if c.ttl < backoff {
+			backoff = c.ttl
+		}
+		c.expiresAt = time.Now().Add(backoff)
+		return c.zones, nil
+	}
+	c.zones = zones
+	c.expiresAt = time.Now().Add(c.ttl)
+	return zones, nil
+}
+
+func (c *ZoneCatalog) discover(ctx context.Context) ([]Zone, error) {
+	result := []Zone{}
+	for _, p := range c.providers {
+		cloud := p.CloudProvider()
+		discovered, err := p.ListZones(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list zones of %s: %s", cloud, err)
+		}
+
+		// Merge discovered and static zones
+		gpus := map[string]map[genesis.GPUKind]struct{}{}
+		for _, zone := range discovered {
+			if _, ok := gpus[zone.Name]; !ok {
+				gpus[zone.Name] = map[genesis.GPUKind]struct{}{}
+			}
+			for _, gpu := range zone.GPUs {
+				gpus[zone.Name][gpu] = struct{}{}
+			}
+		}
+		if c.catalog != nil {
+			for _, zone := range c.catalog.zones[cloud] {
+				if zone.Disabled {
+					delete(gpus, zone.Name)
+					continue
+				}
+				if _, ok := gpus[zone.Name]; !ok {
+					gpus[zone.Name] = map[genesis.GPUKind]struct{}{}
+				}
+				for _, gpu := range zone.GPUs {
+					gpus[zone.Name][genesis.GPUKind(gpu)] = struct{}{}
+				}
+			}
+		}
+
+		zones := make([]Zone, 0, len(gpus))
+		for name, kinds := range gpus {
+			zone := Zone{CloudProvider: cloud, Name: name, GPUs: []genesis.GPUKind{}}
+			for kind := range kinds {
+				zone.GPUs = append(zone.GPUs, kind)
+			}
+			sort.Slice(zone.GPUs, func(i, j int) bool { return zone.GPUs[i] < zone.GPUs[j] })
+			zones = append(zones, zone)
+		}
+		sort.Slice(zones, func(i, j int) bool { return zones[i].Name < zones[j].Name })
+		result = append(result, zones...)
+	}
+	return result, nil
+}
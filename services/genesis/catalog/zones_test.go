@@ -0,0 +1,155 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/provider/fake"
+)
+
+func TestZoneCatalogMerge(t *testing.T) {
+	ctx := context.Background()
+	gcp := genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM
+	p := fake.NewProvider(gcp,
+		provider.Zone{Name: "us-east1-c", GPUs: []genesis.GPUKind{}},
+		provider.Zone{Name: "europe-west1-b", GPUs: []genesis.GPUKind{
+			genesis.GPUKind_GPU_KIND_TESLA_T4,
+		}},
+		provider.Zone{Name: "europe-west4-a", GPUs: []genesis.GPUKind{}},
+	)
+	catalog, err := NewCatalog(Config{Providers: []ProviderConfig{{
+		CloudProvider: CloudProvider(gcp),
+		Zones: []ZoneConfig{
+			{Name: "europe-west1-b", GPUs: []GPUKind{GPUKind(genesis.GPUKind_GPU_KIND_TESLA_K80)}},
+			{Name: "europe-west4-a", Disabled: true},
+			{Name: "asia-east1-a"},
+		},
+	}, {
+		// Static zones of cloud providers without provider must be ignored
+		CloudProvider: CloudProvider(genesis.CloudProvider_CLOUD_PROVIDER_AMAZON_WEB_SERVICES),
+		Zones:         []ZoneConfig{{Name: "us-east-1a"}},
+	}}})
+	require.Nil(t, err)
+
+	zones, err := NewZoneCatalog([]provider.Provider{p}, catalog, time.Minute).Zones(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, []Zone{{
+		CloudProvider: gcp,
+		Name:          "asia-east1-a",
+		GPUs:          []genesis.GPUKind{},
+	}, {
+		CloudProvider: gcp,
+		Name:          "europe-west1-b",
+		GPUs: []genesis.GPUKind{
+			genesis.GPUKind_GPU_KIND_TESLA_K80, genesis.GPUKind_GPU_KIND_TESLA_T4,
+		},
+	}, {
+		CloudProvider: gcp,
+		Name:          "us-east1-c",
+		GPUs:          []genesis.GPUKind{},
+	}}, zones)
+}
+
+func TestZoneCatalogCache(t *testing.T) {
+	ctx := context.Background()
+	p := &flakyProvider{Provider: fake.NewProvider(
+		genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		provider.Zone{Name: "us-east1-c"},
+	)}
+	zones := NewZoneCatalog([]provider.Provider{p}, nil, 50*time.Millisecond)
+
+	// Zones cannot be returned if they have never been discovered
+	p.fail = true
+	_, err := zones.Zones(ctx)
+	assert.NotNil(t, err)
+
+	// Zones must be cached
+	p.fail = false
+	_, ok, err := zones.Zone(ctx, p.CloudProvider(), "us-east1-c")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	_, err = zones.Zones(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, 2, p.calls)
+
+	// Stale zones must be returned if discovery fails
+	time.Sleep(50 * time.Millisecond)
+	p.fail = true
+	_, ok, err = zones.Zone(ctx, p.CloudProvider(), "us-east1-c")
+	require.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 3, p.calls)
+
+	// Discovery must not be retried immediately after a failure
+	_, err = zones.Zones(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, 3, p.calls)
+	time.Sleep(50 * time.Millisecond)
+	_, err = zones.Zones(ctx)
+	require.Nil(t, err)
+	assert.Equal(t, 4, p.calls)
+}
+
+func TestZoneCatalogConcurrentDiscovery(t *testing.T) {
+	ctx := context.Background()
+	p := &slowProvider{
+		Provider: fake.NewProvider(
+			genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+			provider.Zone{Name: "us-east1-c"},
+		),
+		release: make(chan struct{}),
+	}
+	zones := NewZoneCatalog([]provider.Provider{p}, nil, time.Minute)
+
+	// Concurrent callers must share a single discovery
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := zones.Zones(ctx)
+			assert.Nil(t, err)
+			assert.Len(t, result, 1)
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(p.release)
+	wg.Wait()
+	assert.Equal(t, int32(1), p.calls.Load())
+}
+
+//-------------------------------------------------------------------------------------------------
+
+type flakyProvider struct {
+	*fake.Provider
+	fail  bool
+	calls int
+}
+
+func (p *flakyProvider) ListZones(ctx context.Context) ([]provider.Zone, error) {
+	p.calls++
+	if p.fail {
+		return nil, fmt.Errorf("provider unavailable")
+	}
+	return p.Provider.ListZones(ctx)
+}
+
+type slowProvider struct {
+	*fake.Provider
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (p *slowProvider) ListZones(ctx context.Context) ([]provider.Zone, error) {
+	p.calls.Add(1)
+	<-p.release
+	return p.Provider.ListZones(ctx)
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant"
+	"go.taskfleet.io/packages/eagle"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// idempotentMethods are the methods of the Genesis service which may safely be retried. Shutting
+// down an instance is not idempotent as a retry returns `NOT_FOUND` if the original call
+// succeeded.
+var idempotentMethods = map[string]struct{}{
+	genesis.GenesisService_ListZones_FullMethodName:           {},
+	genesis.GenesisService_CreateInstance_FullMethodName:      {},
+	genesis.GenesisService_CreateInstanceGroup_FullMethodName: {},
+	genesis.GenesisService_ListInstances_FullMethodName:       {},
+	genesis.GenesisService_InstanceHeartbeat_FullMethodName:   {},
+	genesis.GenesisService_GetCostReport_FullMethodName:       {},
+}
+
+// Config describes how to connect to the Genesis service.
+type Config struct {
+	// The address of the Genesis service, e.g. `genesis:50051`.
+	Target string `json:"target"`
+	// The TLS configuration used to connect to the service. If no CA certificate is set, the
+	// connection is not secured.
+	TLS eagle.ClientTLS `json:"tls"`
+}
+
+// Client is a client for the Genesis service. Idempotent calls, i.e. all unary calls except for
+// `ShutdownInstance`, are retried if the service is unavailable or an attempt exceeds its timeout.
+// If the client is configured with a subscriber for instance events (see `WithEvents`), it
+// additionally allows to wait for the creation of instances via `CreateAndWait`.
+type Client struct {
+	genesis.GenesisServiceClient
+
+	conn           *grpc.ClientConn
+	dialOptions    []grpc.DialOption
+	maxAttempts    uint
+	backoff        time.Duration
+	attemptTimeout time.Duration
+	subscriber     dymant.Subscriber
+	waiters        *waiterSet
+}
+
+// Dial creates a new client which connects to the Genesis service described by the given
+// configuration. Like `grpc.Dial`, it does not wait for the connection to be established. The
+// client must be closed once it is not needed anymore.
+func Dial(ctx context.Context, config Config, options ...Option) (*Client, error) {
+	tlsConfig, err := config.TLS.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TLS configuration: %s", err)
+	}
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	c := newClient(options)
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, c.dialOptions...)
+	conn, err := grpc.DialContext(ctx, config.Target, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %q: %s", config.Target, err)
+	}
+	c.conn = conn
+	c.GenesisServiceClient = genesis.NewGenesisServiceClient(c.retrying(conn))
+	return c, nil
+}
+
+// NewClient creates a new client which uses the given connection to the Genesis service. Closing
+// the client does not close the connection. Dial options passed via `WithDialOptions` are ignored.
+func NewClient(conn grpc.ClientConnInterface, options ...Option) *Client {
+	c := newClient(options)
+	c.GenesisServiceClient = genesis.NewGenesisServiceClient(c.retrying(conn))
+	return c
+}
+
+func newClient(options []Option) *Client {
+	c := &Client{
+		maxAttempts: 5,
+		backoff:     100 * time.Millisecond,
+		waiters:     newWaiterSet(),
+	}
+	for _, option := range options {
+		option.apply(c)
+	}
+	return c
+}
+
+// Close closes the connection to the Genesis service if it was established by `Dial`. The
+// subscriber for instance events is not closed.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+//-------------------------------------------------------------------------------------------------
+// RETRIES
+//-------------------------------------------------------------------------------------------------
+
+// retryingConn wraps a connection such that idempotent unary calls are retried.
+type retryingConn struct {
+	grpc.ClientConnInterface
+	retry grpc.UnaryClientInterceptor
+}
+
+func (c *Client) retrying(conn grpc.ClientConnInterface) grpc.ClientConnInterface {
+	options := []grpc_retry.CallOption{
+		grpc_retry.WithMax(c.maxAttempts),
+		grpc_retry.WithBackoff(grpc_retry.BackoffExponentialWithJitter(c.backoff, 0.1)),
+		grpc_retry.WithCodes(codes.Unavailable),
+	}
+	if c.attemptTimeout > 0 {
+		options = append(options, grpc_retry.WithPerRetryTimeout(c.attemptTimeout))
+	}
+	return retryingConn{
+		ClientConnInterface: conn,
+		retry:               grpc_retry.UnaryClientInterceptor(options...),
+	}
+}
+
+func (c retryingConn) Invoke(
+	ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption,
+) error {
+	if _, ok := idempotentMethods[method]; !ok {
+		return c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+	}
+	return c.retry(ctx, method, args, reply, nil, c.invoke, opts...)
+}
+
+func (c retryingConn) invoke(
+	ctx context.Context, method string, args, reply interface{}, _ *grpc.ClientConn,
+	opts ...grpc.CallOption,
+) error {
+	return c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+}
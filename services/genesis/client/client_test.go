@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant/memory"
+	"go.taskfleet.io/packages/mercury"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/provider/fake"
+	"go.taskfleet.io/services/genesis/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestCreateAndWait(t *testing.T) {
+	ctx, fakeProvider, client := newClientFixture(t)
+
+	// Successful creations must return the created instance
+	req := createRequest()
+	response, err := client.CreateAndWait(ctx, req)
+	require.Nil(t, err)
+	assert.NotEmpty(t, response.Hostname)
+
+	// Retries for instances which are already running must return immediately
+	retryCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	retried, err := client.CreateAndWait(retryCtx, req)
+	require.Nil(t, err)
+	assert.Equal(t, response.Hostname, retried.Hostname)
+
+	// Failed creations must return the reason
+	fakeProvider.SetCreateHook(func(ctx context.Context, spec provider.InstanceSpec) error {
+		return fmt.Errorf("no capacity: %w", provider.ErrInsufficientResources)
+	})
+	req = createRequest()
+	_, err = client.CreateAndWait(ctx, req)
+	var failed *CreationFailedError
+	require.True(t, errors.As(err, &failed))
+	assert.Equal(t,
+		genesis_messages.InstanceCreationFailedEvent_REASON_INSUFFICIENT_RESOURCES, failed.Reason,
+	)
+
+	// Retries for instances which already failed must return the failure immediately
+	retryCtx, cancel = context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	_, err = client.CreateAndWait(retryCtx, req)
+	var retriedFailure *CreationFailedError
+	require.True(t, errors.As(err, &retriedFailure))
+	assert.Equal(t, failed, retriedFailure)
+
+	// Invalid requests must fail immediately
+	req = createRequest()
+	req.Owner = ""
+	_, err = client.CreateAndWait(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateAndWaitWithoutSubscriber(t *testing.T) {
+	client := NewClient(nil)
+	_, err := client.CreateAndWait(context.Background(), createRequest())
+	assert.ErrorIs(t, err, ErrNoSubscriber)
+	assert.ErrorIs(t, client.Run(context.Background()), ErrNoSubscriber)
+}
+
+func TestRetries(t *testing.T) {
+	ctx := context.Background()
+	server := &unavailableServer{failures: 2}
+	client := dialTestServer(t, server, WithRetries(3, time.Millisecond))
+
+	// Idempotent calls must be retried
+	_, err := client.ListZones(ctx, &genesis.ListZonesRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, int32(3), server.calls.Load())
+
+	// Retries must be bounded
+	server.calls.Store(0)
+	server.failures = 3
+	_, err = client.ListZones(ctx, &genesis.ListZonesRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(3), server.calls.Load())
+
+	// Non-idempotent calls must not be retried
+	server.calls.Store(0)
+	_, err = client.ShutdownInstance(ctx, &genesis.ShutdownInstanceRequest{
+		Instance: &genesis.Instance{Id: uuid.NewString()},
+	})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), server.calls.Load())
+}
+
+func TestAttemptTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	server := &unavailableServer{delays: 1}
+	client := dialTestServer(t, server,
+		WithRetries(2, time.Millisecond), WithAttemptTimeout(50*time.Millisecond),
+	)
+
+	// Attempts exceeding the timeout must be retried
+	_, err := client.ListZones(ctx, &genesis.ListZonesRequest{})
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), server.calls.Load())
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func newClientFixture(t *testing.T) (context.Context, *fake.Provider, *Client) {
+	ctx, cancel := context.WithTimeout(zeus.WithNopLogger(context.Background()), 10*time.Second)
+	t.Cleanup(cancel)
+
+	queue := memory.NewQueue(10)
+	fakeProvider := fake.NewProvider(genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		provider.Zone{Name: "europe-west1-b"},
+	)
+	s, err := service.NewService([]provider.Provider{fakeProvider}, service.WithPublisher(queue))
+	require.Nil(t, err)
+	client := dialTestServer(t, s, WithEvents(queue))
+
+	for _, runnable := range []mercury.Runnable{s, client} {
+		runnable := runnable
+		runCtx, runCancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			runnable.Run(runCtx) // nolint:errcheck
+		}()
+		t.Cleanup(func() {
+			runCancel()
+			<-done
+		})
+	}
+	return ctx, fakeProvider, client
+}
+
+func dialTestServer(
+	t *testing.T, server genesis.GenesisServiceServer, options ...Option,
+) *Client {
+	grpcServer, err := mercury.NewGrpc(0, mercury.WithRequestValidation())
+	require.Nil(t, err)
+	genesis.RegisterGenesisServiceServer(grpcServer.Server, server)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go grpcServer.Server.Serve(listener) // nolint:errcheck
+	t.Cleanup(grpcServer.Server.Stop)
+
+	options = append(options, WithDialOptions(
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	))
+	client, err := Dial(context.Background(), Config{Target: "bufnet"}, options...)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		client.Close() // nolint:errcheck
+	})
+	return client
+}
+
+func createRequest() *genesis.CreateInstanceRequest {
+	return &genesis.CreateInstanceRequest{
+		Id:        uuid.NewString(),
+		Owner:     "owner",
+		Component: "worker",
+		Config: &genesis.InstanceConfig{
+			CloudProvider: genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+			Zone:          "europe-west1-b",
+		},
+		Resources: &genesis.InstanceResources{CpuCount: 4, Memory: 16384},
+	}
+}
+
+// unavailableServer fails the given number of calls as unavailable and delays the given number of
+// calls until they are cancelled.
+type unavailableServer struct {
+	genesis.UnimplementedGenesisServiceServer
+	failures int32
+	delays   int32
+	calls    atomic.Int32
+}
+
+func (s *unavailableServer) ListZones(
+	ctx context.Context, req *genesis.ListZonesRequest,
+) (*genesis.ListZonesResponse, error) {
+	if err := s.call(ctx); err != nil {
+		return nil, err
+	}
+	return &genesis.ListZonesResponse{}, nil
+}
+
+func (s *unavailableServer) ShutdownInstance(
+	ctx context.Context, req *genesis.ShutdownInstanceRequest,
+) (*genesis.ShutdownInstanceResponse, error) {
+	if err := s.call(ctx); err != nil {
+		return nil, err
+	}
+	return &genesis.ShutdownInstanceResponse{}, nil
+}
+
+func (s *unavailableServer) call(ctx context.Context) error {
+	call := s.calls.Add(1)
+	if call <= s.delays {
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if call <= s.failures {
+		return status.Errorf(codes.Unavailable, "service unavailable")
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNoSubscriber is returned if events are required but the client has no subscriber for
+// instance events.
+var ErrNoSubscriber = errors.New("client has no subscriber for instance events")
+
+// CreationFailedError is returned by `CreateAndWait` if Genesis failed to create the instance.
+type CreationFailedError struct {
+	// The reason why the creation failed.
+	Reason genesis_messages.InstanceCreationFailedEvent_Reason
+	// The message provided by Genesis.
+	Message string
+}
+
+func (e *CreationFailedError) Error() string {
+	return fmt.Sprintf("failed to create instance (%s): %s", e.Reason, e.Message)
+}
+
+// Run consumes instance events from the client's subscriber until the context is cancelled or
+// consumption fails. Events are delivered to pending calls of `CreateAndWait`, all other events
+// are dropped. The client implements the `mercury.Runnable` interface.
+//
+// Each client process must consume all partitions of the instance events topic, i.e. it needs a
+// consumer group of its own. Client processes which share a consumer group split the partitions
+// among themselves and a process may never receive the events of the instances it created.
+func (c *Client) Run(ctx context.Context) error {
+	if c.subscriber == nil {
+		return ErrNoSubscriber
+	}
+	subscriber := dymant.NewTypedSubscriber[*genesis_messages.InstanceEvent](c.subscriber)
+	return subscriber.Process(ctx,
+		func(ctx context.Context, events []*genesis_messages.InstanceEvent) error {
+			for _, event := range events {
+				c.waiters.notify(event)
+			}
+			return nil
+		},
+	)
+}
+
+// CreateAndWait creates an instance and waits until Genesis announces its creation. It returns
+// the event describing the created instance or a `*CreationFailedError` if the creation failed.
+// The client must be running (see `Run`) for the event to be received. If the context is
+// cancelled while waiting, the instance may still be created, i.e. callers should retry with the
+// same request or shut the instance down eventually.
+//
+// When retrying a request whose instance was already created by a previous call, the creation
+// event was published before this call started waiting. In this case, the running instance is
+// looked up via `ListInstances` and returned right away. Similarly, retrying a request whose
+// creation already failed returns the `*CreationFailedError` reported by Genesis right away.
+func (c *Client) CreateAndWait(
+	ctx context.Context, req *genesis.CreateInstanceRequest, opts ...grpc.CallOption,
+) (*genesis_messages.InstanceCreatedEvent, error) {
+	if c.subscriber == nil {
+		return nil, ErrNoSubscriber
+	}
+
+	// Start waiting prior to the creation such that the event cannot be missed
+	ch := c.waiters.add(req.Id)
+	defer c.waiters.remove(req.Id, ch)
+
+	if _, err := c.CreateInstance(ctx, req, opts...); err != nil {
+		if failed := creationFailure(err); failed != nil {
+			return nil, failed
+		}
+		return nil, err
+	}
+	running, err := c.findRunning(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if running != nil {
+		return &genesis_messages.InstanceCreatedEvent{
+			Config:    running.Config,
+			Resources: running.Resources,
+			Hostname:  running.Hostname,
+			Labels:    running.Labels,
+		}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to await creation of instance %s: %w", req.Id, ctx.Err())
+	case event := <-ch:
+		if failed := event.GetCreationFailed(); failed != nil {
+			return nil, &CreationFailedError{Reason: failed.Reason, Message: failed.Message}
+		}
+		return event.GetCreated(), nil
+	}
+}
+
+// findRunning returns the running instance created for the given request or nil if the instance
+// is not running (yet).
+func (c *Client) findRunning(
+	ctx context.Context, req *genesis.CreateInstanceRequest, opts ...grpc.CallOption,
+) (*genesis.RunningInstance, error) {
+	// The filters merely reduce the number of instances to page through
+	list := &genesis.ListInstancesRequest{
+		Owner:         req.Owner,
+		Component:     req.Component,
+		CloudProvider: req.Config.GetCloudProvider(),
+		Zone:          req.Config.GetZone(),
+	}
+	for {
+		response, err := c.ListInstances(ctx, list, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up instance %s: %w", req.Id, err)
+		}
+		for _, instance := range response.Instances {
+			if instance.Instance.GetId() == req.Id {
+				return instance, nil
+			}
+		}
+		if response.NextPageToken == "" {
+			return nil, nil
+		}
+		list.PageToken = response.NextPageToken
+	}
+}
+
+// creationFailure returns the failure that Genesis attaches to the error when a request whose
+// creation already failed is retried or nil if the error carries no such failure.
+func creationFailure(err error) *CreationFailedError {
+	if status.Code(err) != codes.FailedPrecondition {
+		return nil
+	}
+	for _, detail := range status.Convert(err).Details() {
+		if failed, ok := detail.(*genesis_messages.InstanceCreationFailedEvent); ok {
+			return &CreationFailedError{Reason: failed.Reason, Message: failed.Message}
+		}
+	}
+	return nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// WAITERS
+//-------------------------------------------------------------------------------------------------
+
+// waiterSet keeps track of the callers waiting for the creation of instances.
+type waiterSet struct {
+	mutex   sync.Mutex
+	waiters map[string][]chan *genesis_messages.InstanceEvent
+}
+
+func newWaiterSet() *waiterSet {
+	return &waiterSet{waiters: map[string][]chan *genesis_messages.InstanceEvent{}}
+}
+
+// add registers a new waiter for the instance with the given ID.
+func (s *waiterSet) add(id string) chan *genesis_messages.InstanceEvent {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	ch := make(chan *genesis_messages.InstanceEvent, 1)
+	s.waiters[id] = append(s.waiters[id], ch)
+	return ch
+}
+
+// remove unregisters the given waiter for the instance with the given ID.
+func (s *waiterSet) remove(id string, ch chan *genesis_messages.InstanceEvent) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	waiters := s.waiters[id]
+	for i, w := range waiters {
+		if w == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(s.waiters, id)
+	} else {
+		s.waiters[id] = waiters
+	}
+}
+
+// notify delivers the given event to all waiters of the instance it refers to if it announces
+// the outcome of the instance's creation.
+func (s *waiterSet) notify(event *genesis_messages.InstanceEvent) {
+	if event.GetCreated() == nil && event.GetCreationFailed() == nil {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, ch := range s.waiters[event.Instance.GetId()] {
+		// Waiters only consume a single event, duplicates are dropped
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
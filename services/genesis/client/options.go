@@ -0,0 +1,92 @@
+package client
+
+import (
+	"time"
+
+	"go.taskfleet.io/packages/dymant"
+	"google.golang.org/grpc"
+)
+
+// Option allows to customize the Genesis client.
+type Option interface {
+	apply(c *Client)
+}
+
+//-------------------------------------------------------------------------------------------------
+// RETRIES
+//-------------------------------------------------------------------------------------------------
+
+type optionRetries struct {
+	maxAttempts uint
+	backoff     time.Duration
+}
+
+// WithRetries sets the maximum number of attempts of idempotent calls along with the backoff
+// before the first retry. The backoff grows exponentially with every retry. If the maximum number
+// of attempts is one, calls are not retried. If this option is not set, calls are attempted up to
+// five times with an initial backoff of 100ms.
+func WithRetries(maxAttempts uint, backoff time.Duration) Option {
+	return optionRetries{maxAttempts, backoff}
+}
+
+func (o optionRetries) apply(c *Client) {
+	c.maxAttempts = o.maxAttempts
+	c.backoff = o.backoff
+}
+
+//-------------------------------------------------------------------------------------------------
+// ATTEMPT TIMEOUT
+//-------------------------------------------------------------------------------------------------
+
+type optionAttemptTimeout struct {
+	timeout time.Duration
+}
+
+// WithAttemptTimeout sets the deadline of each individual attempt of an idempotent call. Attempts
+// which exceed the timeout are retried as long as the deadline of the call's context has not been
+// exceeded. If this option is not set, attempts are only bounded by the call's context.
+func WithAttemptTimeout(timeout time.Duration) Option {
+	return optionAttemptTimeout{timeout}
+}
+
+func (o optionAttemptTimeout) apply(c *Client) {
+	c.attemptTimeout = o.timeout
+}
+
+//-------------------------------------------------------------------------------------------------
+// EVENTS
+//-------------------------------------------------------------------------------------------------
+
+type optionEvents struct {
+	subscriber dymant.Subscriber
+}
+
+// WithEvents sets the subscriber from which the client consumes the instance events published by
+// Genesis. The subscriber's messages must be of type `genesis_messages.InstanceEvent`. The client
+// must be run (see `Run`) in order to consume events. The subscriber must use a consumer group
+// which is not shared with any other client process since processes sharing a group split the
+// topic's partitions among themselves and may miss the events of their own instances.
+func WithEvents(subscriber dymant.Subscriber) Option {
+	return optionEvents{subscriber}
+}
+
+func (o optionEvents) apply(c *Client) {
+	c.subscriber = o.subscriber
+}
+
+//-------------------------------------------------------------------------------------------------
+// DIAL OPTIONS
+//-------------------------------------------------------------------------------------------------
+
+type optionDial struct {
+	options []grpc.DialOption
+}
+
+// WithDialOptions adds the given options when connecting to the Genesis service via `Dial`.
+func WithDialOptions(options ...grpc.DialOption) Option {
+	return optionDial{options}
+}
+
+func (o optionDial) apply(c *Client) {
+	c.dialOptions = append(c.dialOptions, o.options...)
+}
@@ -0,0 +1,161 @@
+package component
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/eagle"
+	"go.taskfleet.io/services/genesis/catalog"
+	"go.taskfleet.io/services/genesis/provider"
+	"golang.org/x/exp/slices"
+)
+
+// Config describes the contents of a component registry file.
+type Config struct {
+	// The configurations of all components, keyed by the name of the component.
+	Components map[string]Component `json:"components"`
+}
+
+// Component describes how the instances of a single component are created.
+type Component struct {
+	// The provider-specific image from which instances are booted.
+	Image string `json:"image"`
+	// The script that is run when an instance boots. May either be given inline or reference a
+	// file via `{"file": "<path>"}`.
+	StartupScript eagle.String `json:"startupScript"`
+	// The size of the boot disk in gigabytes. If zero, the provider's default is used.
+	DiskSize uint32 `json:"diskSize,omitempty"`
+	// Labels that are attached to all instances of the component as tags.
+	Labels map[string]string `json:"labels,omitempty"`
+	// The zones in which instances of the component may be created. If empty, instances may be
+	// created in all zones.
+	Zones []string `json:"zones,omitempty"`
+	// The kinds of GPUs that may be attached to instances of the component. If empty, instances
+	// may use all kinds of GPUs.
+	GPUKinds []catalog.GPUKind `json:"gpuKinds,omitempty"`
+}
+
+// AllowsZone returns whether instances of the component may be created in the given zone.
+func (c Component) AllowsZone(zone string) bool {
+	return len(c.Zones) == 0 || slices.Contains(c.Zones, zone)
+}
+
+// AllowsGPU returns whether GPUs of the given kind may be attached to instances of the component.
+func (c Component) AllowsGPU(kind genesis.GPUKind) bool {
+	if len(c.GPUKinds) == 0 {
+		return true
+	}
+	for _, k := range c.GPUKinds {
+		if genesis.GPUKind(k) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Component) validate() error {
+	if c.Image == "" {
+		return fmt.Errorf("image must be set")
+	}
+	for key := range c.Labels {
+		if key == "" {
+			return fmt.Errorf("label keys must not be empty")
+		}
+		if strings.HasPrefix(key, provider.TagPrefix) {
+			return fmt.Errorf("label key %q uses reserved prefix %q", key, provider.TagPrefix)
+		}
+	}
+	for _, zone := range c.Zones {
+		if zone == "" {
+			return fmt.Errorf("zones must not be empty")
+		}
+	}
+	for _, kind := range c.GPUKinds {
+		if genesis.GPUKind(kind) == genesis.GPUKind_GPU_KIND_UNSPECIFIED {
+			return fmt.Errorf("GPU kind must not be unspecified")
+		}
+	}
+	return nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// REGISTRY
+//-------------------------------------------------------------------------------------------------
+
+// Registry provides the configurations of all components. The configurations can be reloaded from
+// their sources at runtime (see `Reload` and `Reloader`). The registry is safe for concurrent use.
+type Registry struct {
+	sources []eagle.ConfigSource
+
+	mutex      sync.RWMutex
+	components map[string]Component
+}
+
+// LoadRegistry loads the component configurations from the given sources (e.g.
+// `eagle.WithYAMLFile`) and validates them. The same sources are used when reloading the
+// registry.
+func LoadRegistry(sources ...eagle.ConfigSource) (*Registry, error) {
+	r := &Registry{sources: sources}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// NewRegistry creates a new registry with the given static configuration. It returns an error if
+// the configuration is invalid. Reloading the registry is a noop.
+func NewRegistry(config Config) (*Registry, error) {
+	components, err := parseConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Registry{components: components}, nil
+}
+
+// Component returns the configuration of the component with the given name and whether it exists.
+func (r *Registry) Component(name string) (Component, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	component, ok := r.components[name]
+	return component, ok
+}
+
+// Reload loads the component configurations from the registry's sources and replaces the current
+// configurations if they are valid. If loading fails, the current configurations are retained.
+// It returns whether the configurations changed.
+func (r *Registry) Reload() (bool, error) {
+	if r.sources == nil {
+		return false, nil
+	}
+
+	var config Config
+	if err := eagle.LoadConfig(&config, r.sources...); err != nil {
+		return false, fmt.Errorf("failed to load components: %s", err)
+	}
+	components, err := parseConfig(config)
+	if err != nil {
+		return false, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if reflect.DeepEqual(r.components, components) {
+		return false, nil
+	}
+	r.components = components
+	return true, nil
+}
+
+func parseConfig(config Config) (map[string]Component, error) {
+	components := map[string]Component{}
+	for name, component := range config.Components {
+		if err := component.validate(); err != nil {
+			return nil, fmt.Errorf("invalid configuration for component %q: %s", name, err)
+		}
+		components[name] = component
+	}
+	return components, nil
+}
@@ -0,0 +1,81 @@
+package component
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/eagle"
+)
+
+func TestLoadRegistry(t *testing.T) {
+	registry, err := LoadRegistry(eagle.WithYAMLFile("testdata/components.yaml", false))
+	require.Nil(t, err)
+
+	worker, ok := registry.Component("worker")
+	require.True(t, ok)
+	assert.Equal(t, "taskfleet/worker-v1", worker.Image)
+	assert.Equal(t, "#!/bin/sh\ntaskfleet-worker --daemon\n", worker.StartupScript.Value())
+	assert.Equal(t, uint32(100), worker.DiskSize)
+	assert.Equal(t, map[string]string{"team": "ml"}, worker.Labels)
+	assert.True(t, worker.AllowsZone("europe-west1-b"))
+	assert.False(t, worker.AllowsZone("us-east1-c"))
+	assert.True(t, worker.AllowsGPU(genesis.GPUKind_GPU_KIND_TESLA_T4))
+	assert.False(t, worker.AllowsGPU(genesis.GPUKind_GPU_KIND_TESLA_A100))
+
+	scheduler, ok := registry.Component("scheduler")
+	require.True(t, ok)
+	assert.True(t, scheduler.AllowsZone("us-east1-c"))
+	assert.True(t, scheduler.AllowsGPU(genesis.GPUKind_GPU_KIND_TESLA_A100))
+
+	_, ok = registry.Component("unknown")
+	assert.False(t, ok)
+}
+
+func TestNewRegistryInvalid(t *testing.T) {
+	configs := []Component{
+		{},
+		{Image: "image", Labels: map[string]string{"taskfleet-owner": "owner"}},
+		{Image: "image", Zones: []string{""}},
+	}
+	for _, config := range configs {
+		_, err := NewRegistry(Config{Components: map[string]Component{"worker": config}})
+		assert.NotNil(t, err)
+	}
+}
+
+func TestRegistryReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "components.yaml")
+	write := func(content string) {
+		require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	write("components: {worker: {image: worker-v1}}")
+
+	registry, err := LoadRegistry(eagle.WithYAMLFile(path, false))
+	require.Nil(t, err)
+
+	// Unchanged configurations are not replaced
+	changed, err := registry.Reload()
+	require.Nil(t, err)
+	assert.False(t, changed)
+
+	// Changed configurations are applied
+	write("components: {worker: {image: worker-v2}}")
+	changed, err = registry.Reload()
+	require.Nil(t, err)
+	assert.True(t, changed)
+	worker, ok := registry.Component("worker")
+	require.True(t, ok)
+	assert.Equal(t, "worker-v2", worker.Image)
+
+	// Invalid configurations are ignored
+	write("components: {worker: {diskSize: 10}}")
+	_, err = registry.Reload()
+	assert.NotNil(t, err)
+	worker, ok = registry.Component("worker")
+	require.True(t, ok)
+	assert.Equal(t, "worker-v2", worker.Image)
+}
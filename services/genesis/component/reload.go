@@ -0,0 +1,43 @@
+package component
+
+import (
+	"context"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"go.uber.org/zap"
+)
+
+// Reloader periodically reloads a registry from its sources such that changes to the component
+// configurations are applied without restarting the service. Invalid configurations are logged
+// and ignored, i.e. the registry keeps using the last valid configurations. The reloader
+// implements the `mercury.Runnable` interface.
+type Reloader struct {
+	registry *Registry
+	interval time.Duration
+}
+
+// NewReloader creates a new reloader which reloads the given registry at the provided interval.
+func NewReloader(registry *Registry, interval time.Duration) *Reloader {
+	return &Reloader{registry: registry, interval: interval}
+}
+
+// Run reloads the registry at the configured interval until the context is cancelled.
+func (r *Reloader) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			changed, err := r.registry.Reload()
+			if err != nil {
+				zeus.Logger(ctx).Error("failed to reload component registry", zap.Error(err))
+			} else if changed {
+				zeus.Logger(ctx).Info("reloaded component registry")
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package health
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// GrpcProber checks the health of instances via the gRPC health checking protocol. It connects to
+// the hostname of an instance at a fixed port and considers the instance healthy if the health
+// service reports the instance to be serving.
+type GrpcProber struct {
+	port        int
+	service     string
+	dialOptions []grpc.DialOption
+}
+
+// NewGrpcProber creates a new prober which connects to instances at the provided port.
+func NewGrpcProber(port int, options ...GrpcProberOption) *GrpcProber {
+	p := &GrpcProber{
+		port: port,
+		dialOptions: []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	}
+	for _, option := range options {
+		option.apply(p)
+	}
+	return p
+}
+
+// Probe implements the Prober interface.
+func (p *GrpcProber) Probe(ctx context.Context, instance *genesis.RunningInstance) error {
+	target := net.JoinHostPort(instance.Hostname, strconv.Itoa(p.port))
+	conn, err := grpc.DialContext(ctx, target, p.dialOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %q: %s", target, err)
+	}
+	defer conn.Close() // nolint:errcheck
+
+	client := grpc_health_v1.NewHealthClient(conn)
+	response, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: p.service})
+	if err != nil {
+		return fmt.Errorf("failed to check health of %q: %s", target, err)
+	}
+	if response.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("instance at %q reports status %s", target, response.Status)
+	}
+	return nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// OPTIONS
+//-------------------------------------------------------------------------------------------------
+
+// GrpcProberOption allows to customize the gRPC prober.
+type GrpcProberOption interface {
+	apply(p *GrpcProber)
+}
+
+type grpcProberOptionService struct {
+	service string
+}
+
+// WithService sets the name of the service whose health is checked. If this option is not set,
+// the overall health of the server is checked.
+func WithService(service string) GrpcProberOption {
+	return grpcProberOptionService{service}
+}
+
+func (o grpcProberOptionService) apply(p *GrpcProber) {
+	p.service = o.service
+}
+
+type grpcProberOptionTLS struct {
+	config *tls.Config
+}
+
+// WithTLS uses the specified TLS configuration when connecting to instances. If a value of `nil`
+// is provided or this option is not set, connections are not secured.
+func WithTLS(config *tls.Config) GrpcProberOption {
+	return grpcProberOptionTLS{config}
+}
+
+func (o grpcProberOptionTLS) apply(p *GrpcProber) {
+	if o.config == nil {
+		p.dialOptions[0] = grpc.WithTransportCredentials(insecure.NewCredentials())
+	} else {
+		p.dialOptions[0] = grpc.WithTransportCredentials(credentials.NewTLS(o.config))
+	}
+}
+
+type grpcProberOptionDial struct {
+	options []grpc.DialOption
+}
+
+// WithDialOptions adds the given options whenever connecting to an instance.
+func WithDialOptions(options ...grpc.DialOption) GrpcProberOption {
+	return grpcProberOptionDial{options}
+}
+
+func (o grpcProberOptionDial) apply(p *GrpcProber) {
+	p.dialOptions = append(p.dialOptions, o.options...)
+}
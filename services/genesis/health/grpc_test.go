@@ -0,0 +1,41 @@
+package health
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/mercury"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestGrpcProber(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := mercury.NewGrpc(0, mercury.WithHealthService())
+	require.Nil(t, err)
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Server.Serve(listener) // nolint:errcheck
+	defer server.Server.Stop()
+
+	prober := NewGrpcProber(8080, WithDialOptions(
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	))
+	instance := &genesis.RunningInstance{Hostname: "instance.internal"}
+
+	server.Health().SetHealthy(true)
+	assert.Nil(t, prober.Probe(ctx, instance))
+
+	server.Health().SetHealthy(false)
+	assert.NotNil(t, prober.Probe(ctx, instance))
+
+	// Probing an instance without a running health service fails
+	server.Server.Stop()
+	assert.NotNil(t, prober.Probe(ctx, instance))
+}
@@ -0,0 +1,22 @@
+package health
+
+import (
+	"context"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+)
+
+// Prober checks the health of running instances.
+type Prober interface {
+	// Probe returns an error if the given instance is unhealthy. The context carries the deadline
+	// until which the probe must have completed.
+	Probe(ctx context.Context, instance *genesis.RunningInstance) error
+}
+
+// ProberFunc is an adapter to allow the use of ordinary functions as probers.
+type ProberFunc func(ctx context.Context, instance *genesis.RunningInstance) error
+
+// Probe implements the Prober interface.
+func (f ProberFunc) Probe(ctx context.Context, instance *genesis.RunningInstance) error {
+	return f(ctx, instance)
+}
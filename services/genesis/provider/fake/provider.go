@@ -0,0 +1,186 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/provider"
+)
+
+// Provider is a provider which manages instances purely in-memory and can be used for testing
+// purposes. Instances are "created" immediately unless a creation hook is set. The provider is
+// thread-safe.
+type Provider struct {
+	cloud      genesis.CloudProvider
+	zones      []provider.Zone
+	mutex      sync.Mutex
+	instances  map[uuid.UUID]provider.Instance
+	createHook func(context.Context, provider.InstanceSpec) error
+	watchers   map[*func(provider.Preemption)]struct{}
+}
+
+// NewProvider initializes a new in-memory provider which pretends to manage instances of the
+// specified cloud provider in the given zones.
+func NewProvider(cloud genesis.CloudProvider, zones ...provider.Zone) *Provider {
+	return &Provider{
+		cloud:     cloud,
+		zones:     zones,
+		instances: map[uuid.UUID]provider.Instance{},
+		watchers:  map[*func(provider.Preemption)]struct{}{},
+	}
+}
+
+//-------------------------------------------------------------------------------------------------
+// PROVIDER
+//-------------------------------------------------------------------------------------------------
+
+// CloudProvider implements the provider.Provider interface.
+func (p *Provider) CloudProvider() genesis.CloudProvider {
+	return p.cloud
+}
+
+// ListZones implements the provider.Provider interface.
+func (p *Provider) ListZones(ctx context.Context) ([]provider.Zone, error) {
+	return p.zones, nil
+}
+
+// CreateInstance implements the provider.Provider interface.
+func (p *Provider) CreateInstance(
+	ctx context.Context, spec provider.InstanceSpec,
+) (provider.Instance, error) {
+	p.mutex.Lock()
+	hook := p.createHook
+	p.mutex.Unlock()
+
+	if hook != nil {
+		if err := hook(ctx, spec); err != nil {
+			return provider.Instance{}, err
+		}
+	}
+
+	instance := provider.Instance{
+		ID:          spec.ID,
+		Zone:        spec.Zone,
+		IsSpot:      spec.IsSpot,
+		MachineType: spec.MachineType,
+		Hostname:    fmt.Sprintf("%s.%s.fake.internal", spec.ID, spec.Zone),
+		Tags:        spec.Tags,
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if _, ok := p.instances[spec.ID]; ok {
+		return provider.Instance{}, fmt.Errorf("instance %s already exists", spec.ID)
+	}
+	p.instances[spec.ID] = instance
+	return instance, nil
+}
+
+// DeleteInstance implements the provider.Provider interface.
+func (p *Provider) DeleteInstance(ctx context.Context, zone string, id uuid.UUID) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if instance, ok := p.instances[id]; !ok || instance.Zone != zone {
+		return fmt.Errorf("failed to delete instance %s: %w", id, provider.ErrNotFound)
+	}
+	delete(p.instances, id)
+	return nil
+}
+
+// ListInstances implements the provider.Provider interface.
+func (p *Provider) ListInstances(ctx context.Context) ([]provider.Instance, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	result := make([]provider.Instance, 0, len(p.instances))
+	for _, instance := range p.instances {
+		result = append(result, instance)
+	}
+	return result, nil
+}
+
+// WatchPreemptions implements the provider.Provider interface.
+func (p *Provider) WatchPreemptions(
+	ctx context.Context, handler func(provider.Preemption),
+) error {
+	p.mutex.Lock()
+	p.watchers[&handler] = struct{}{}
+	p.mutex.Unlock()
+
+	<-ctx.Done()
+
+	p.mutex.Lock()
+	delete(p.watchers, &handler)
+	p.mutex.Unlock()
+	return ctx.Err()
+}
+
+//-------------------------------------------------------------------------------------------------
+// CONVENIENCE
+//-------------------------------------------------------------------------------------------------
+
+// SetCreateHook sets a function that is called whenever an instance ought to be created. If the
+// hook returns an error, instance creation fails with that error. The hook may block to simulate
+// long-running instance creation. Passing `nil` removes the hook.
+func (p *Provider) SetCreateHook(hook func(context.Context, provider.InstanceSpec) error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.createHook = hook
+}
+
+// AddInstance adds the given instance to the provider as if it had been created externally.
+func (p *Provider) AddInstance(instance provider.Instance) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.instances[instance.ID] = instance
+}
+
+// Terminate removes the instance with the specified ID as if the cloud provider terminated it.
+// It returns whether the instance existed.
+func (p *Provider) Terminate(id uuid.UUID) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	_, ok := p.instances[id]
+	delete(p.instances, id)
+	return ok
+}
+
+// Instance returns the instance with the specified ID if it exists.
+func (p *Provider) Instance(id uuid.UUID) (provider.Instance, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	instance, ok := p.instances[id]
+	return instance, ok
+}
+
+// Preempt removes the instance with the specified ID as if the cloud provider preempted it and
+// synchronously notifies all preemption watchers. It returns whether the instance existed.
+func (p *Provider) Preempt(id uuid.UUID) bool {
+	p.mutex.Lock()
+	instance, ok := p.instances[id]
+	delete(p.instances, id)
+	handlers := make([]func(provider.Preemption), 0, len(p.watchers))
+	for handler := range p.watchers {
+		handlers = append(handlers, *handler)
+	}
+	p.mutex.Unlock()
+
+	if !ok {
+		return false
+	}
+	preemption := provider.Preemption{ID: id, Zone: instance.Zone, Time: time.Now()}
+	for _, handler := range handlers {
+		handler(preemption)
+	}
+	return true
+}
+
+// Watching returns whether any preemption watcher is currently registered.
+func (p *Provider) Watching() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.watchers) > 0
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+)
+
+var (
+	// ErrNotFound is returned by providers if an instance that is referenced does not exist.
+	ErrNotFound = errors.New("instance not found")
+	// ErrInsufficientResources is returned by providers if an instance cannot be created since
+	// the cloud provider does not have sufficient resources available in the requested zone.
+	ErrInsufficientResources = errors.New("insufficient resources")
+	// ErrQuotaExceeded is returned by providers if an instance cannot be created since the quotas
+	// of the cloud provider account are exhausted.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+const (
+	// TagPrefix is the prefix of all tags that are attached to instances by Genesis itself. Other
+	// tags must not use this prefix.
+	TagPrefix = "taskfleet-"
+	// TagOwner is the tag attached to all instances created by Genesis. It stores the owner that
+	// requested the instance.
+	TagOwner = TagPrefix + "owner"
+	// TagComponent is the tag attached to all instances created by Genesis. It stores the
+	// component for which the instance was created.
+	TagComponent = TagPrefix + "component"
+)
+
+// Provider is implemented by types which manage the compute instances of a single cloud provider.
+// Implementations must be safe for concurrent use.
+type Provider interface {
+	// CloudProvider returns the cloud provider whose instances are managed by this provider.
+	CloudProvider() genesis.CloudProvider
+
+	// ListZones returns all zones in which the provider is able to create instances along with
+	// the GPUs that are available in each zone.
+	ListZones(ctx context.Context) ([]Zone, error)
+
+	// CreateInstance creates a new instance according to the provided specification. The method
+	// blocks until the instance is up and running or creating the instance failed. Whenever the
+	// cloud provider rejects the instance due to missing resources or quotas, the returned error
+	// should wrap `ErrInsufficientResources` or `ErrQuotaExceeded`, respectively.
+	CreateInstance(ctx context.Context, spec InstanceSpec) (Instance, error)
+
+	// DeleteInstance deletes the instance with the specified ID in the given zone. If the
+	// instance does not exist, the returned error must wrap `ErrNotFound`.
+	DeleteInstance(ctx context.Context, zone string, id uuid.UUID) error
+
+	// ListInstances returns all instances that currently exist in the cloud provider and which
+	// are managed by this provider, regardless of the entity that created them.
+	ListInstances(ctx context.Context) ([]Instance, error)
+
+	// WatchPreemptions calls the handler whenever the cloud provider announces that a spot
+	// instance is about to be preempted or was preempted. The method blocks until the context is
+	// cancelled or watching fails. The same preemption might be reported multiple times.
+	WatchPreemptions(ctx context.Context, handler func(Preemption)) error
+}
+
+// Zone describes a single zone of a cloud provider.
+type Zone struct {
+	// The provider-specific name of the zone.
+	Name string
+	// The kinds of GPUs that can be attached to instances in the zone.
+	GPUs []genesis.GPUKind
+}
+
+// InstanceSpec describes the instance that ought to be created by a provider.
+type InstanceSpec struct {
+	// The globally unique identifier of the instance.
+	ID uuid.UUID
+	// The zone in which to create the instance.
+	Zone string
+	// Whether the instance should be created as spot instance.
+	IsSpot bool
+	// The provider-specific machine type of the instance. If empty, the provider must choose a
+	// machine type which provides the requested resources.
+	MachineType string
+	// The resources that the instance must provide.
+	Resources *genesis.InstanceResources
+	// The provider-specific image from which the instance is booted. If empty, the provider's
+	// default image is used.
+	Image string
+	// The script to run when the instance boots, if any.
+	StartupScript string
+	// The size of the instance's boot disk in gigabytes. If zero, the provider's default is used.
+	DiskSize uint32
+	// Tags to attach to the instance.
+	Tags map[string]string
+}
+
+// Instance describes an instance that exists in a cloud provider.
+type Instance struct {
+	// The globally unique identifier of the instance.
+	ID uuid.UUID
+	// The zone in which the instance is running.
+	Zone string
+	// Whether the instance is a spot instance.
+	IsSpot bool
+	// The provider-specific machine type of the instance, if known.
+	MachineType string
+	// The hostname via which the instance can be reached.
+	Hostname string
+	// The tags attached to the instance.
+	Tags map[string]string
+}
+
+// IsManaged returns whether the instance was created by Genesis, i.e. carries Taskfleet tags.
+func (i Instance) IsManaged() bool {
+	_, ok := i.Tags[TagOwner]
+	return ok
+}
+
+// Preemption describes the preemption of a spot instance by the cloud provider.
+type Preemption struct {
+	// The ID of the preempted instance.
+	ID uuid.UUID
+	// The zone in which the preempted instance is running.
+	Zone string
+	// The time at which the instance is reclaimed by the cloud provider. Might be in the past if
+	// the instance was already reclaimed.
+	Time time.Time
+}
@@ -0,0 +1,185 @@
+package quota
+
+import (
+	"errors"
+	"fmt"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/eagle"
+	"go.taskfleet.io/services/genesis/catalog"
+)
+
+// ErrExceeded is returned if creating an instance would exceed a quota.
+var ErrExceeded = errors.New("quota exceeded")
+
+// Config describes the quotas of owners and components. Owners and components without quotas are
+// not restricted.
+type Config struct {
+	// Quotas of individual owners, keyed by owner.
+	Owners map[string]Limits `json:"owners"`
+	// Quotas of individual components, keyed by component. Component quotas apply to the
+	// instances of all owners.
+	Components map[string]Limits `json:"components"`
+}
+
+// Limits describes the maximum resources that may be used by all instances that are pending or
+// running at the same time. Unset limits do not restrict resource usage.
+type Limits struct {
+	// The maximum number of instances.
+	MaxInstances *uint32 `json:"maxInstances,omitempty"`
+	// The maximum total number of CPUs.
+	MaxCPUs *uint32 `json:"maxCpus,omitempty"`
+	// The maximum total amount of memory in megabytes.
+	MaxMemory *uint32 `json:"maxMemory,omitempty"`
+	// The maximum total number of GPUs per kind, keyed by the name of the GPU kind, e.g.
+	// `GPU_KIND_TESLA_T4`.
+	MaxGPUs map[catalog.GPUKind]uint32 `json:"maxGpus,omitempty"`
+}
+
+// Enforcer enforces quotas. It is immutable and, thus, safe for concurrent use.
+type Enforcer struct {
+	owners     map[string]limits
+	components map[string]limits
+}
+
+// LoadEnforcer loads the quota configuration from the given sources (e.g. `eagle.WithYAMLFile`)
+// and creates an enforcer for it.
+func LoadEnforcer(sources ...eagle.ConfigSource) (*Enforcer, error) {
+	var config Config
+	if err := eagle.LoadConfig(&config, sources...); err != nil {
+		return nil, fmt.Errorf("failed to load quotas: %s", err)
+	}
+	return NewEnforcer(config)
+}
+
+// NewEnforcer creates a new enforcer for the quotas described by the given configuration. It
+// returns an error if the configuration is invalid.
+func NewEnforcer(config Config) (*Enforcer, error) {
+	e := &Enforcer{owners: map[string]limits{}, components: map[string]limits{}}
+	for owner, l := range config.Owners {
+		parsed, err := parseLimits(l)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quota for owner %q: %s", owner, err)
+		}
+		e.owners[owner] = parsed
+	}
+	for component, l := range config.Components {
+		parsed, err := parseLimits(l)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quota for component %q: %s", component, err)
+		}
+		e.components[component] = parsed
+	}
+	return e, nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// ENFORCEMENT
+//-------------------------------------------------------------------------------------------------
+
+// Instance describes an instance that counts towards quotas.
+type Instance struct {
+	// The owner of the instance.
+	Owner string
+	// The component of the instance.
+	Component string
+	// The actual resources of the instance.
+	Resources *genesis.InstanceResources
+}
+
+// Check verifies that the given instance can be created without exceeding the quotas of its
+// owner and its component. The active instances are all instances that are pending or running
+// at the moment. If a quota would be exceeded, the returned error wraps `ErrExceeded`.
+func (e *Enforcer) Check(instance Instance, active []Instance) error {
+	if l, ok := e.owners[instance.Owner]; ok {
+		usage := newUsage(instance, active, func(i Instance) bool {
+			return i.Owner == instance.Owner
+		})
+		if err := l.check(usage); err != nil {
+			return fmt.Errorf("%w for owner %q: %s", ErrExceeded, instance.Owner, err)
+		}
+	}
+	if l, ok := e.components[instance.Component]; ok {
+		usage := newUsage(instance, active, func(i Instance) bool {
+			return i.Component == instance.Component
+		})
+		if err := l.check(usage); err != nil {
+			return fmt.Errorf("%w for component %q: %s", ErrExceeded, instance.Component, err)
+		}
+	}
+	return nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// UTILITIES
+//-------------------------------------------------------------------------------------------------
+
+type limits struct {
+	instances *uint32
+	cpus      *uint32
+	memory    *uint32
+	gpus      map[genesis.GPUKind]uint32
+}
+
+func parseLimits(l Limits) (limits, error) {
+	result := limits{
+		instances: l.MaxInstances,
+		cpus:      l.MaxCPUs,
+		memory:    l.MaxMemory,
+		gpus:      map[genesis.GPUKind]uint32{},
+	}
+	for kind, count := range l.MaxGPUs {
+		if genesis.GPUKind(kind) == genesis.GPUKind_GPU_KIND_UNSPECIFIED {
+			return limits{}, fmt.Errorf("GPU kind must be specified")
+		}
+		result.gpus[genesis.GPUKind(kind)] = count
+	}
+	return result, nil
+}
+
+func (l limits) check(u usage) error {
+	if l.instances != nil && u.instances > uint64(*l.instances) {
+		return fmt.Errorf("at most %d instances are allowed", *l.instances)
+	}
+	if l.cpus != nil && u.cpus > uint64(*l.cpus) {
+		return fmt.Errorf("at most %d CPUs are allowed", *l.cpus)
+	}
+	if l.memory != nil && u.memory > uint64(*l.memory) {
+		return fmt.Errorf("at most %d MB of memory are allowed", *l.memory)
+	}
+	for kind, count := range u.gpus {
+		if limit, ok := l.gpus[kind]; ok && count > uint64(limit) {
+			return fmt.Errorf("at most %d GPUs of kind %s are allowed", limit, kind)
+		}
+	}
+	return nil
+}
+
+type usage struct {
+	instances uint64
+	cpus      uint64
+	memory    uint64
+	gpus      map[genesis.GPUKind]uint64
+}
+
+// newUsage computes the usage of all active instances matching the filter, including the given
+// instance.
+func newUsage(instance Instance, active []Instance, filter func(Instance) bool) usage {
+	u := usage{gpus: map[genesis.GPUKind]uint64{}}
+	u.add(instance.Resources)
+	for _, i := range active {
+		if filter(i) {
+			u.add(i.Resources)
+		}
+	}
+	return u
+}
+
+func (u *usage) add(resources *genesis.InstanceResources) {
+	u.instances++
+	u.cpus += uint64(resources.CpuCount)
+	u.memory += uint64(resources.Memory)
+	if gpu := resources.GetGpu(); gpu != nil {
+		u.gpus[gpu.Kind] += uint64(gpu.Count)
+	}
+}
@@ -0,0 +1,102 @@
+package quota
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/eagle"
+	"go.taskfleet.io/services/genesis/catalog"
+)
+
+func TestCheck(t *testing.T) {
+	enforcer, err := LoadEnforcer(eagle.WithYAMLFile("testdata/quotas.yaml", false))
+	require.Nil(t, err)
+
+	small := &genesis.InstanceResources{CpuCount: 2, Memory: 8192}
+	gpu := &genesis.InstanceResources{CpuCount: 2, Memory: 8192, Gpu: &genesis.GPUResources{
+		Kind: genesis.GPUKind_GPU_KIND_TESLA_T4, Count: 1,
+	}}
+
+	testCases := []struct {
+		name     string
+		instance Instance
+		active   []Instance
+		exceeded bool
+	}{{
+		name:     "within quotas",
+		instance: Instance{Owner: "scheduler", Component: "worker", Resources: gpu},
+		active:   []Instance{{Owner: "scheduler", Component: "worker", Resources: small}},
+	}, {
+		name:     "owner without quota",
+		instance: Instance{Owner: "other", Component: "other", Resources: gpu},
+		active: []Instance{
+			{Owner: "other", Component: "other", Resources: gpu},
+			{Owner: "other", Component: "other", Resources: gpu},
+		},
+	}, {
+		name:     "max instances",
+		instance: Instance{Owner: "scheduler", Component: "other", Resources: small},
+		active: []Instance{
+			{Owner: "scheduler", Component: "other", Resources: small},
+			{Owner: "scheduler", Component: "other", Resources: small},
+		},
+		exceeded: true,
+	}, {
+		name:     "max gpus",
+		instance: Instance{Owner: "scheduler", Component: "other", Resources: gpu},
+		active:   []Instance{{Owner: "scheduler", Component: "other", Resources: gpu}},
+		exceeded: true,
+	}, {
+		name:     "component cpus across owners",
+		instance: Instance{Owner: "a", Component: "worker", Resources: small},
+		active: []Instance{
+			{Owner: "b", Component: "worker", Resources: small},
+			{Owner: "c", Component: "worker", Resources: small},
+			{Owner: "d", Component: "worker", Resources: small},
+			{Owner: "d", Component: "other", Resources: small},
+		},
+	}, {
+		name:     "component memory across owners",
+		instance: Instance{Owner: "a", Component: "worker", Resources: small},
+		active: []Instance{
+			{Owner: "b", Component: "worker", Resources: small},
+			{Owner: "c", Component: "worker", Resources: small},
+			{Owner: "d", Component: "worker", Resources: &genesis.InstanceResources{
+				CpuCount: 1, Memory: 16384,
+			}},
+		},
+		exceeded: true,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := enforcer.Check(tc.instance, tc.active)
+			if tc.exceeded {
+				assert.ErrorIs(t, err, ErrExceeded)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestNewEnforcerInvalid(t *testing.T) {
+	_, err := NewEnforcer(Config{Owners: map[string]Limits{
+		"scheduler": {MaxGPUs: map[catalog.GPUKind]uint32{
+			catalog.GPUKind(genesis.GPUKind_GPU_KIND_UNSPECIFIED): 1,
+		}},
+	}})
+	assert.NotNil(t, err)
+}
+
+func TestLoadEnforcerUnknownGPUKind(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quotas.yaml")
+	data := []byte("owners:\n  scheduler:\n    maxGpus:\n      TESLA_T4: 1\n")
+	require.Nil(t, os.WriteFile(path, data, 0o600))
+	_, err := LoadEnforcer(eagle.WithYAMLFile(path, false))
+	assert.ErrorContains(t, err, "TESLA_T4")
+}
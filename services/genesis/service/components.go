@@ -0,0 +1,75 @@
+package service
+
+import (
+	"fmt"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateComponent ensures that the component of the given request is configured and allows the
+// requested zone and GPU kind (if any). If no component registry is configured, all requests are
+// valid.
+func (s *Service) validateComponent(
+	component string, config *genesis.InstanceConfig, resources *genesis.InstanceResources,
+) error {
+	if s.components == nil {
+		return nil
+	}
+	c, ok := s.components.Component(component)
+	if !ok {
+		return status.Errorf(
+			codes.InvalidArgument, "no configuration found for component %q", component,
+		)
+	}
+	if !c.AllowsZone(config.Zone) {
+		return status.Errorf(codes.InvalidArgument,
+			"zone %q is not allowed for component %q", config.Zone, component,
+		)
+	}
+	if gpu := resources.GetGpu(); gpu != nil && !c.AllowsGPU(gpu.Kind) {
+		return status.Errorf(codes.InvalidArgument,
+			"GPU %s is not allowed for component %q", gpu.Kind, component,
+		)
+	}
+	return nil
+}
+
+// instanceSpec returns the specification from which the given instance is created by its
+// provider. The configuration of the instance's component is looked up when the instance is
+// actually created, i.e. changes to the component registry apply to pending instances as well.
+// The instance is tagged with the labels of its component and its request where the labels of the
+// request take precedence.
+func (s *Service) instanceSpec(instance store.Instance) (provider.InstanceSpec, error) {
+	spec := provider.InstanceSpec{
+		ID:          instance.ID,
+		Zone:        instance.Config.Zone,
+		IsSpot:      instance.Config.IsSpot,
+		MachineType: instance.MachineType,
+		Resources:   instance.Resources,
+		Tags:        map[string]string{},
+	}
+	if s.components != nil {
+		c, ok := s.components.Component(instance.Component)
+		if !ok {
+			return provider.InstanceSpec{}, fmt.Errorf(
+				"no configuration found for component %q", instance.Component,
+			)
+		}
+		spec.Image = c.Image
+		spec.StartupScript = c.StartupScript.Value()
+		spec.DiskSize = c.DiskSize
+		for key, value := range c.Labels {
+			spec.Tags[key] = value
+		}
+	}
+	for key, value := range instance.Request.GetLabels() {
+		spec.Tags[key] = value
+	}
+	spec.Tags[provider.TagOwner] = instance.Owner
+	spec.Tags[provider.TagComponent] = instance.Component
+	return spec, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetCostReport implements the genesis.GenesisServiceServer interface.
+func (s *Service) GetCostReport(
+	ctx context.Context, req *genesis.GetCostReportRequest,
+) (*genesis.GetCostReportResponse, error) {
+	now := time.Now()
+	start, end := req.StartTime.AsTime(), now
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	if !end.After(start) {
+		return nil, status.Errorf(codes.InvalidArgument, "end time must be after start time")
+	}
+
+	// Failed instances never ran and pending instances do not run yet, i.e. they do not accrue
+	// any cost
+	instances, err := s.store.List(ctx, store.Filter{
+		Owner:     req.Owner,
+		Component: req.Component,
+		Statuses:  []store.Status{store.StatusRunning, store.StatusDeleted},
+	})
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	type costKey struct {
+		owner     string
+		component string
+	}
+	entries := map[costKey]*genesis.CostReportEntry{}
+	response := &genesis.GetCostReportResponse{}
+	for _, instance := range instances {
+		hours := instanceHours(instance, start, end, now)
+		if hours <= 0 {
+			continue
+		}
+
+		key := costKey{owner: instance.Owner, component: instance.Component}
+		entry, ok := entries[key]
+		if !ok {
+			entry = &genesis.CostReportEntry{Owner: key.owner, Component: key.component}
+			entries[key] = entry
+			response.Entries = append(response.Entries, entry)
+		}
+		entry.InstanceHours += hours
+		if price, ok := s.hourlyPrice(instance); ok {
+			entry.Cost += hours * price
+			response.TotalCost += hours * price
+		} else {
+			entry.UnpricedInstanceHours += hours
+		}
+	}
+
+	sort.Slice(response.Entries, func(i, j int) bool {
+		left, right := response.Entries[i], response.Entries[j]
+		if left.Owner != right.Owner {
+			return left.Owner < right.Owner
+		}
+		return left.Component < right.Component
+	})
+	return response, nil
+}
+
+// instanceHours returns the number of hours for which the given instance existed within the time
+// window between start and end. Instances which were not deleted yet exist until now.
+func instanceHours(instance store.Instance, start, end, now time.Time) float64 {
+	deletedAt := instance.DeletedAt
+	if deletedAt.IsZero() {
+		deletedAt = now
+	}
+	if instance.CreatedAt.After(start) {
+		start = instance.CreatedAt
+	}
+	if deletedAt.Before(end) {
+		end = deletedAt
+	}
+	return end.Sub(start).Hours()
+}
+
+// hourlyPrice returns the hourly price of the given instance according to the catalog. It returns
+// false if no catalog is configured or the instance's machine type is missing from the catalog.
+func (s *Service) hourlyPrice(instance store.Instance) (float64, bool) {
+	if s.catalog == nil || instance.MachineType == "" {
+		return 0, false
+	}
+	instanceType, ok := s.catalog.InstanceType(
+		instance.Config.GetCloudProvider(), instance.MachineType,
+	)
+	if !ok {
+		return 0, false
+	}
+	return instanceType.HourlyPrice(instance.Config.GetZone(), instance.Config.GetIsSpot()), true
+}
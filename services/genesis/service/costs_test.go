@@ -0,0 +1,139 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/catalog"
+	"go.taskfleet.io/services/genesis/store"
+	memorystore "go.taskfleet.io/services/genesis/store/memory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetCostReport(t *testing.T) {
+	c, err := catalog.NewCatalog(catalog.Config{Providers: []catalog.ProviderConfig{{
+		CloudProvider: catalog.CloudProvider(
+			genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		),
+		InstanceTypes: []catalog.InstanceType{{
+			Name:     "n2-standard-4",
+			CPUCount: 4,
+			Memory:   16384,
+			Price: catalog.Price{OnDemand: 0.2, Spot: 0.05, Zones: map[string]catalog.ZonePrice{
+				"us-east1-c": {OnDemand: 0.3},
+			}},
+		}},
+	}}})
+	require.Nil(t, err)
+
+	start := time.Now().Add(-24 * time.Hour).Truncate(time.Hour)
+	end := start.Add(4 * time.Hour)
+	instanceStore := memorystore.NewStore()
+	f := newServiceFixture(t, WithStore(instanceStore), WithCatalog(c))
+
+	instances := []store.Instance{
+		// Deleted within the window, requested before the window
+		costInstance("a", "worker", "europe-west1-b", false,
+			start.Add(-time.Hour), start.Add(2*time.Hour),
+		),
+		// Spot instance within the window
+		costInstance("a", "worker", "europe-west1-b", true,
+			start.Add(time.Hour), start.Add(3*time.Hour),
+		),
+		// Zone-specific price, running beyond the end of the window
+		costInstance("a", "trainer", "us-east1-c", false, start.Add(3*time.Hour), time.Time{}),
+		// Deleted before the window
+		costInstance("a", "worker", "europe-west1-b", false,
+			start.Add(-2*time.Hour), start.Add(-time.Hour),
+		),
+	}
+	unpriced := costInstance("b", "worker", "europe-west1-b", false, start, time.Time{})
+	unpriced.MachineType = ""
+	failed := costInstance("b", "worker", "europe-west1-b", false, start, start.Add(time.Hour))
+	failed.Status = store.StatusFailed
+	instances = append(instances, unpriced, failed)
+	for _, instance := range instances {
+		require.Nil(t, instanceStore.Create(f.ctx, instance))
+	}
+
+	// Costs must be aggregated per owner and component
+	response, err := f.client.GetCostReport(f.ctx, &genesis.GetCostReportRequest{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	})
+	require.Nil(t, err)
+	require.Len(t, response.Entries, 3)
+	assertCostEntry(t, response.Entries[0], "a", "trainer", 0.3, 1, 0)
+	assertCostEntry(t, response.Entries[1], "a", "worker", 0.5, 4, 0)
+	assertCostEntry(t, response.Entries[2], "b", "worker", 0, 4, 4)
+	assert.InDelta(t, 0.8, response.TotalCost, 1e-9)
+
+	// Reports must be filterable
+	response, err = f.client.GetCostReport(f.ctx, &genesis.GetCostReportRequest{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		Owner:     "a",
+		Component: "worker",
+	})
+	require.Nil(t, err)
+	require.Len(t, response.Entries, 1)
+	assertCostEntry(t, response.Entries[0], "a", "worker", 0.5, 4, 0)
+
+	// Running instances must accrue cost until now if no end time is given
+	response, err = f.client.GetCostReport(f.ctx, &genesis.GetCostReportRequest{
+		StartTime: timestamppb.New(start),
+		Owner:     "b",
+	})
+	require.Nil(t, err)
+	require.Len(t, response.Entries, 1)
+	assert.InDelta(t, time.Since(start).Hours(), response.Entries[0].UnpricedInstanceHours, 0.01)
+
+	// Empty time windows must be rejected
+	_, err = f.client.GetCostReport(f.ctx, &genesis.GetCostReportRequest{
+		StartTime: timestamppb.New(end),
+		EndTime:   timestamppb.New(start),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func costInstance(
+	owner, component, zone string, isSpot bool, createdAt, deletedAt time.Time,
+) store.Instance {
+	status := store.StatusDeleted
+	if deletedAt.IsZero() {
+		status = store.StatusRunning
+	}
+	return store.Instance{
+		ID:        uuid.New(),
+		Owner:     owner,
+		Component: component,
+		Config: &genesis.InstanceConfig{
+			CloudProvider: genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+			Zone:          zone,
+			IsSpot:        isSpot,
+		},
+		MachineType: "n2-standard-4",
+		Status:      status,
+		CreatedAt:   createdAt,
+		DeletedAt:   deletedAt,
+	}
+}
+
+func assertCostEntry(
+	t *testing.T, entry *genesis.CostReportEntry,
+	owner, component string, cost, hours, unpricedHours float64,
+) {
+	assert.Equal(t, owner, entry.Owner)
+	assert.Equal(t, component, entry.Component)
+	assert.InDelta(t, cost, entry.Cost, 1e-9)
+	assert.InDelta(t, hours, entry.InstanceHours, 1e-9)
+	assert.InDelta(t, unpricedHours, entry.UnpricedInstanceHours, 1e-9)
+}
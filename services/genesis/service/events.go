@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/quota"
+	"go.taskfleet.io/services/genesis/store"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// publish publishes the given event, keyed by the ID of the instance it refers to, and notifies
+// all watchers of the instance's owner. Failures are only logged as the state of the instance has
+// already changed when an event is published.
+func (s *Service) publish(
+	ctx context.Context, owner string, event *genesis_messages.InstanceEvent,
+) {
+	s.watchers.notify(owner, event)
+	if s.publisher == nil {
+		return
+	}
+	if err := s.publisher.PublishSync(ctx, uuid.MustParse(event.Instance.Id), event); err != nil {
+		zeus.Logger(ctx).Error("failed to publish instance event",
+			zap.String("id", event.Instance.Id), zap.Error(err),
+		)
+	}
+}
+
+//-------------------------------------------------------------------------------------------------
+// EVENTS
+//-------------------------------------------------------------------------------------------------
+
+func newInstanceEvent(id uuid.UUID) *genesis_messages.InstanceEvent {
+	return &genesis_messages.InstanceEvent{
+		Instance:  &genesis.Instance{Id: id.String()},
+		Timestamp: timestamppb.Now(),
+	}
+}
+
+func instanceCreatedEvent(instance store.Instance) *genesis_messages.InstanceEvent {
+	event := newInstanceEvent(instance.ID)
+	event.Event = &genesis_messages.InstanceEvent_Created{
+		Created: &genesis_messages.InstanceCreatedEvent{
+			Config:    instance.Config,
+			Resources: instance.Resources,
+			Hostname:  instance.Hostname,
+			Labels:    instance.Request.GetLabels(),
+		},
+	}
+	return event
+}
+
+func instanceCreationFailedEvent(id uuid.UUID, err error) *genesis_messages.InstanceEvent {
+	reason := genesis_messages.InstanceCreationFailedEvent_REASON_UNSPECIFIED
+	switch {
+	case errors.Is(err, provider.ErrInsufficientResources):
+		reason = genesis_messages.InstanceCreationFailedEvent_REASON_INSUFFICIENT_RESOURCES
+	case errors.Is(err, provider.ErrQuotaExceeded), errors.Is(err, quota.ErrExceeded),
+		status.Code(err) == codes.ResourceExhausted:
+		reason = genesis_messages.InstanceCreationFailedEvent_REASON_QUOTA_EXCEEDED
+	}
+
+	event := newInstanceEvent(id)
+	event.Event = &genesis_messages.InstanceEvent_CreationFailed{
+		CreationFailed: &genesis_messages.InstanceCreationFailedEvent{
+			Reason:  reason,
+			Message: status.Convert(err).Message(),
+		},
+	}
+	return event
+}
+
+func instanceDeletedEvent(
+	id uuid.UUID, deleted *genesis_messages.InstanceDeletedEvent,
+) *genesis_messages.InstanceEvent {
+	event := newInstanceEvent(id)
+	event.Event = &genesis_messages.InstanceEvent_Deleted{Deleted: deleted}
+	return event
+}
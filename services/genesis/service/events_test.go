@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant/memory"
+	"go.taskfleet.io/services/genesis/provider"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPublishCreatedAndDeleted(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue))
+
+	req := f.createRequest("owner")
+	req.Labels = map[string]string{"job": "training"}
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+
+	event := awaitEvent(t, queue)
+	assert.Equal(t, req.Id, event.Instance.Id)
+	assert.NotNil(t, event.Timestamp)
+	created := event.GetCreated()
+	require.NotNil(t, created)
+	assert.True(t, proto.Equal(req.Config, created.Config))
+	assert.True(t, proto.Equal(req.Resources, created.Resources))
+	assert.NotEmpty(t, created.Hostname)
+	assert.Equal(t, req.Labels, created.Labels)
+
+	_, err = f.client.ShutdownInstance(f.ctx, &genesis.ShutdownInstanceRequest{
+		Instance: &genesis.Instance{Id: req.Id},
+	})
+	require.Nil(t, err)
+
+	event = awaitEvent(t, queue)
+	assert.Equal(t, req.Id, event.Instance.Id)
+	assert.Equal(t,
+		genesis_messages.InstanceDeletedEvent_REASON_SHUTDOWN, event.GetDeleted().GetReason(),
+	)
+}
+
+func TestPublishCreationFailed(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue))
+	f.provider.SetCreateHook(func(ctx context.Context, spec provider.InstanceSpec) error {
+		return fmt.Errorf("no capacity: %w", provider.ErrInsufficientResources)
+	})
+
+	req := f.createRequest("owner")
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+
+	event := awaitEvent(t, queue)
+	assert.Equal(t, req.Id, event.Instance.Id)
+	failed := event.GetCreationFailed()
+	require.NotNil(t, failed)
+	assert.Equal(t,
+		genesis_messages.InstanceCreationFailedEvent_REASON_INSUFFICIENT_RESOURCES, failed.Reason,
+	)
+	assert.Contains(t, failed.Message, "no capacity")
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func awaitEvent(t *testing.T, queue *memory.Queue) *genesis_messages.InstanceEvent {
+	var messages []proto.Message
+	require.Eventually(t, func() bool {
+		messages = queue.GetMessages()
+		return len(messages) > 0
+	}, time.Second, 10*time.Millisecond)
+	require.Len(t, messages, 1)
+	return messages[0].(*genesis_messages.InstanceEvent)
+}
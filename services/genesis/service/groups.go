@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/store"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateInstanceGroup implements the genesis.GenesisServiceServer interface.
+func (s *Service) CreateInstanceGroup(
+	ctx context.Context, req *genesis.CreateInstanceGroupRequest,
+) (*genesis.CreateInstanceGroupResponse, error) {
+	groupID := uuid.MustParse(req.Id)
+	requests := groupMemberRequests(req)
+
+	// If the group has been requested before, the request is a retry
+	existing, err := s.store.List(ctx, store.Filter{GroupID: groupID})
+	if err != nil {
+		return nil, storeError(err)
+	}
+	if len(existing) > 0 {
+		return replayCreateGroup(groupID, existing, requests)
+	}
+
+	// Register all instances of the group as pending
+	instances := make([]store.Instance, 0, len(requests))
+	for _, r := range requests {
+		instance, err := s.pendingInstance(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		instance.GroupID = groupID
+		instances = append(instances, instance)
+	}
+	if err := s.awaitRecovery(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.register(ctx, instances...); err != nil {
+		if !errors.Is(err, store.ErrAlreadyExists) {
+			return nil, err
+		}
+		// A concurrent retry registered the group in the meantime
+		existing, err := s.store.List(ctx, store.Filter{GroupID: groupID})
+		if err != nil {
+			return nil, storeError(err)
+		}
+		return replayCreateGroup(groupID, existing, requests)
+	}
+
+	// And schedule its creation
+	select {
+	case s.jobs <- s.groupCreationJob(instances, nil):
+	case <-ctx.Done():
+		for _, instance := range instances {
+			if err := s.store.Delete(ctx, instance.ID); err != nil {
+				zeus.Logger(ctx).Error("failed to remove unscheduled instance",
+					zap.Stringer("id", instance.ID), zap.Error(err),
+				)
+			}
+		}
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return createGroupResponse(groupID, instances), nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// CREATION
+//-------------------------------------------------------------------------------------------------
+
+// groupCreationJob returns a job which creates all instances of a group. Instances which already
+// exist at their provider are passed as existing instances and are not created again.
+func (s *Service) groupCreationJob(
+	instances []store.Instance, existing map[uuid.UUID]provider.Instance,
+) func(context.Context) {
+	return func(ctx context.Context) {
+		s.createGroup(ctx, instances, existing)
+	}
+}
+
+func (s *Service) createGroup(
+	ctx context.Context, instances []store.Instance, existing map[uuid.UUID]provider.Instance,
+) {
+	groupID := instances[0].GroupID
+	logger := zeus.Logger(ctx).With(zap.Stringer("group", groupID))
+
+	// Create all instances in parallel, creations are aborted as soon as any creation fails
+	created := make([]provider.Instance, len(instances))
+	err := func() error {
+		ctx, cancel := context.WithTimeout(ctx, s.creationTimeout)
+		defer cancel()
+		eg, ctx := errgroup.WithContext(ctx)
+		for i, instance := range instances {
+			if e, ok := existing[instance.ID]; ok {
+				created[i] = e
+				continue
+			}
+			i, instance := i, instance
+			eg.Go(func() error {
+				spec, err := s.instanceSpec(instance)
+				if err != nil {
+					return err
+				}
+				p := s.providers[instance.Config.CloudProvider]
+				c, err := p.CreateInstance(ctx, spec)
+				if err != nil {
+					return fmt.Errorf("failed to create instance %s: %w", instance.ID, err)
+				}
+				created[i] = c
+				return nil
+			})
+		}
+		return eg.Wait()
+	}()
+	if err != nil && ctx.Err() != nil {
+		// The service is shutting down, the creation is resumed once the service restarts
+		logger.Warn("aborted instance group creation due to shutdown")
+		return
+	}
+	if err != nil {
+		logger.Error("failed to create instance group", zap.Error(err))
+		s.rollbackGroup(ctx, instances, created, err)
+		return
+	}
+
+	for i, instance := range instances {
+		s.markRunning(ctx, instance, created[i])
+	}
+	logger.Info("created instance group", zap.Int("size", len(instances)))
+	s.publishGroup(ctx, instanceGroupCreatedEvent(instances, created))
+}
+
+// rollbackGroup deletes all created instances of a group whose creation failed with the given
+// error. All instances of the group are marked as failed. Instances which fail to be deleted are
+// eventually deleted by the reconciler.
+func (s *Service) rollbackGroup(
+	ctx context.Context, instances []store.Instance, created []provider.Instance, err error,
+) {
+	for i, instance := range instances {
+		if created[i].ID == uuid.Nil {
+			continue
+		}
+		p := s.providers[instance.Config.CloudProvider]
+		err := p.DeleteInstance(ctx, instance.Config.Zone, instance.ID)
+		if err != nil && !errors.Is(err, provider.ErrNotFound) {
+			zeus.Logger(ctx).Error("failed to roll back instance of group",
+				zap.Stringer("id", instance.ID), zap.Error(err),
+			)
+		}
+	}
+	for _, instance := range instances {
+		s.markFailed(ctx, instance, err)
+	}
+	s.publishGroup(ctx, instanceGroupCreationFailedEvent(instances[0].GroupID, err))
+}
+
+// recoverGroup returns a job which resumes the creation of the group with the given pending
+// instances. If any instance of the group failed before, the creation of the group is rolled
+// back instead.
+func (s *Service) recoverGroup(
+	ctx context.Context, pending []store.Instance, existing map[uuid.UUID]provider.Instance,
+) (func(context.Context), error) {
+	groupID := pending[0].GroupID
+	members, err := s.store.List(ctx, store.Filter{GroupID: groupID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances of group %s: %s", groupID, err)
+	}
+
+	existingMembers := map[uuid.UUID]provider.Instance{}
+	for _, instance := range pending {
+		if e, ok := existing[instance.ID]; ok {
+			existingMembers[instance.ID] = e
+		}
+	}
+	for _, member := range members {
+		if member.Status == store.StatusFailed {
+			return func(ctx context.Context) {
+				created := jack.SliceMap(pending, func(i store.Instance) provider.Instance {
+					return existingMembers[i.ID]
+				})
+				err := fmt.Errorf("creation of instance %s of group failed", member.ID)
+				s.rollbackGroup(ctx, pending, created, err)
+			}, nil
+		}
+	}
+	return s.groupCreationJob(pending, existingMembers), nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// EVENTS
+//-------------------------------------------------------------------------------------------------
+
+// publishGroup publishes the given group event, keyed by the ID of the group it refers to.
+// Failures are only logged.
+func (s *Service) publishGroup(ctx context.Context, event *genesis_messages.InstanceGroupEvent) {
+	if s.groupPublisher == nil {
+		return
+	}
+	id := uuid.MustParse(event.Group.Id)
+	if err := s.groupPublisher.PublishSync(ctx, id, event); err != nil {
+		zeus.Logger(ctx).Error("failed to publish instance group event",
+			zap.Stringer("group", id), zap.Error(err),
+		)
+	}
+}
+
+func newInstanceGroupEvent(id uuid.UUID) *genesis_messages.InstanceGroupEvent {
+	return &genesis_messages.InstanceGroupEvent{
+		Group:     &genesis.InstanceGroup{Id: id.String()},
+		Timestamp: timestamppb.Now(),
+	}
+}
+
+func instanceGroupCreatedEvent(
+	instances []store.Instance, created []provider.Instance,
+) *genesis_messages.InstanceGroupEvent {
+	members := make([]*genesis_messages.InstanceGroupMember, len(instances))
+	for i, instance := range instances {
+		members[i] = &genesis_messages.InstanceGroupMember{
+			Instance: &genesis.Instance{Id: instance.ID.String()},
+			Hostname: created[i].Hostname,
+		}
+	}
+	event := newInstanceGroupEvent(instances[0].GroupID)
+	event.Event = &genesis_messages.InstanceGroupEvent_Created{
+		Created: &genesis_messages.InstanceGroupCreatedEvent{
+			Config:    instances[0].Config,
+			Resources: instances[0].Resources,
+			Members:   members,
+		},
+	}
+	return event
+}
+
+func instanceGroupCreationFailedEvent(
+	id uuid.UUID, err error,
+) *genesis_messages.InstanceGroupEvent {
+	failed := instanceCreationFailedEvent(id, err).GetCreationFailed()
+	event := newInstanceGroupEvent(id)
+	event.Event = &genesis_messages.InstanceGroupEvent_CreationFailed{
+		CreationFailed: &genesis_messages.InstanceGroupCreationFailedEvent{
+			Reason:  failed.Reason,
+			Message: failed.Message,
+		},
+	}
+	return event
+}
+
+//-------------------------------------------------------------------------------------------------
+// UTILITIES
+//-------------------------------------------------------------------------------------------------
+
+// groupMemberRequests returns the requests for the individual instances of the requested group.
+// The IDs of the instances are derived from the ID of the group.
+func groupMemberRequests(req *genesis.CreateInstanceGroupRequest) []*genesis.CreateInstanceRequest {
+	groupID := uuid.MustParse(req.Id)
+	requests := make([]*genesis.CreateInstanceRequest, req.Size)
+	for i := range requests {
+		requests[i] = &genesis.CreateInstanceRequest{
+			Id:          uuid.NewSHA1(groupID, []byte(strconv.Itoa(i))).String(),
+			Owner:       req.Owner,
+			Component:   req.Component,
+			Config:      req.Config,
+			Resources:   req.Resources,
+			PreferHpc:   req.PreferHpc,
+			Labels:      req.Labels,
+			MaxLifetime: req.MaxLifetime,
+			IdleTimeout: req.IdleTimeout,
+		}
+	}
+	return requests
+}
+
+func createGroupResponse(
+	groupID uuid.UUID, instances []store.Instance,
+) *genesis.CreateInstanceGroupResponse {
+	return &genesis.CreateInstanceGroupResponse{
+		Group: &genesis.InstanceGroup{Id: groupID.String()},
+		Instances: jack.SliceMap(instances, func(i store.Instance) *genesis.Instance {
+			return &genesis.Instance{Id: i.ID.String()}
+		}),
+		Config:    instances[0].Config,
+		Resources: instances[0].Resources,
+	}
+}
+
+// replayCreateGroup returns the response of the original request that created the group with the
+// given instances if the provided member requests match the requests of the instances.
+// Otherwise, it returns an error indicating that the group already exists.
+func replayCreateGroup(
+	groupID uuid.UUID, instances []store.Instance, requests []*genesis.CreateInstanceRequest,
+) (*genesis.CreateInstanceGroupResponse, error) {
+	mismatch := status.Errorf(codes.AlreadyExists,
+		"instance group %s was already requested with a different specification", groupID,
+	)
+	if len(instances) != len(requests) {
+		return nil, mismatch
+	}
+	// Instances are sorted by their creation time which does not need to coincide with the order
+	// of the requests
+	byID := map[string]store.Instance{}
+	for _, instance := range instances {
+		byID[instance.ID.String()] = instance
+	}
+	ordered := make([]store.Instance, len(requests))
+	for i, req := range requests {
+		instance, ok := byID[req.Id]
+		if !ok || !proto.Equal(instance.Request, req) {
+			return nil, mismatch
+		}
+		ordered[i] = instance
+	}
+	return createGroupResponse(groupID, ordered), nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant/memory"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/provider/fake"
+	"go.taskfleet.io/services/genesis/quota"
+	"go.taskfleet.io/services/genesis/store"
+	memorystore "go.taskfleet.io/services/genesis/store/memory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCreateInstanceGroup(t *testing.T) {
+	queue := memory.NewQueue(10)
+	groupQueue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue), WithGroupPublisher(groupQueue))
+
+	req := f.createGroupRequest("owner", 3)
+	response, err := f.client.CreateInstanceGroup(f.ctx, req)
+	require.Nil(t, err)
+	assert.Equal(t, req.Id, response.Group.Id)
+	require.Len(t, response.Instances, 3)
+
+	// All instances must be running and belong to the group
+	running := f.awaitRunning("owner", 3)
+	for _, instance := range running {
+		assert.Equal(t, req.Id, instance.Group.GetId())
+	}
+	assert.ElementsMatch(t,
+		f.instanceIDs(running),
+		jack.SliceMap(response.Instances, (*genesis.Instance).GetId),
+	)
+
+	// The group event must be published along with the events of the individual instances
+	event := awaitGroupEvent(t, groupQueue)
+	assert.Equal(t, req.Id, event.Group.Id)
+	require.NotNil(t, event.GetCreated())
+	assert.Len(t, event.GetCreated().Members, 3)
+	for _, member := range event.GetCreated().Members {
+		assert.NotEmpty(t, member.Hostname)
+	}
+	assert.Len(t, queue.GetMessages(), 3)
+}
+
+func TestCreateInstanceGroupRollback(t *testing.T) {
+	queue := memory.NewQueue(10)
+	groupQueue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue), WithGroupPublisher(groupQueue))
+
+	req := f.createGroupRequest("owner", 3)
+	failing := groupMemberRequests(req)[1].Id
+	f.provider.SetCreateHook(func(ctx context.Context, spec provider.InstanceSpec) error {
+		if spec.ID.String() == failing {
+			return fmt.Errorf("no capacity: %w", provider.ErrInsufficientResources)
+		}
+		return nil
+	})
+	_, err := f.client.CreateInstanceGroup(f.ctx, req)
+	require.Nil(t, err)
+
+	// No instance of the group must remain
+	event := awaitGroupEvent(t, groupQueue)
+	failed := event.GetCreationFailed()
+	require.NotNil(t, failed)
+	assert.Equal(t,
+		genesis_messages.InstanceCreationFailedEvent_REASON_INSUFFICIENT_RESOURCES, failed.Reason,
+	)
+	instances, err := f.provider.ListInstances(f.ctx)
+	require.Nil(t, err)
+	assert.Empty(t, instances)
+
+	messages := queue.GetMessages()
+	require.Len(t, messages, 3)
+	for _, message := range messages {
+		assert.NotNil(t, message.(*genesis_messages.InstanceEvent).GetCreationFailed())
+	}
+}
+
+func TestCreateInstanceGroupIdempotent(t *testing.T) {
+	maxInstances := uint32(3)
+	enforcer, err := quota.NewEnforcer(quota.Config{Owners: map[string]quota.Limits{
+		"owner": {MaxInstances: &maxInstances},
+	}})
+	require.Nil(t, err)
+	f := newServiceFixture(t, WithQuotas(enforcer))
+
+	// Groups must be rejected as a whole if they exceed quotas
+	_, err = f.client.CreateInstanceGroup(f.ctx, f.createGroupRequest("owner", 4))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Retries must return the original response
+	req := f.createGroupRequest("owner", 2)
+	response, err := f.client.CreateInstanceGroup(f.ctx, req)
+	require.Nil(t, err)
+	retried, err := f.client.CreateInstanceGroup(f.ctx, req)
+	require.Nil(t, err)
+	assert.Equal(t,
+		jack.SliceMap(response.Instances, (*genesis.Instance).GetId),
+		jack.SliceMap(retried.Instances, (*genesis.Instance).GetId),
+	)
+	f.awaitRunning("owner", 2)
+
+	// Reusing the group ID with a different specification must fail
+	req.Size = 1
+	_, err = f.client.CreateInstanceGroup(f.ctx, req)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestRecoverFailedInstanceGroup(t *testing.T) {
+	ctx, cancel := context.WithCancel(zeus.WithNopLogger(context.Background()))
+	defer cancel()
+
+	// Simulate a restart where one instance of a group failed while the rollback of another
+	// instance was still pending
+	f := &serviceFixture{t: t}
+	req := f.createGroupRequest("owner", 2)
+	requests := groupMemberRequests(req)
+	instanceStore := memorystore.NewStore()
+	failed := pendingInstance(requests[0])
+	failed.GroupID = uuid.MustParse(req.Id)
+	failed.Status = store.StatusFailed
+	require.Nil(t, instanceStore.Create(ctx, failed))
+	created := pendingInstance(requests[1])
+	created.GroupID = failed.GroupID
+	require.Nil(t, instanceStore.Create(ctx, created))
+
+	fakeProvider := fake.NewProvider(genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		fixtureZones...,
+	)
+	fakeProvider.AddInstance(provider.Instance{ID: created.ID, Zone: created.Config.Zone})
+	service, err := NewService([]provider.Provider{fakeProvider}, WithStore(instanceStore))
+	require.Nil(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.Run(ctx) // nolint:errcheck
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// The created instance must be rolled back
+	require.Eventually(t, func() bool {
+		instance, err := instanceStore.Get(ctx, created.ID)
+		require.Nil(t, err)
+		return instance.Status == store.StatusFailed
+	}, time.Second, 10*time.Millisecond)
+	_, ok := fakeProvider.Instance(created.ID)
+	assert.False(t, ok)
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func (f *serviceFixture) createGroupRequest(
+	owner string, size uint32,
+) *genesis.CreateInstanceGroupRequest {
+	req := f.createRequest(owner)
+	return &genesis.CreateInstanceGroupRequest{
+		Id:        req.Id,
+		Size:      size,
+		Owner:     req.Owner,
+		Component: req.Component,
+		Config:    req.Config,
+		Resources: req.Resources,
+	}
+}
+
+func awaitGroupEvent(t *testing.T, queue *memory.Queue) *genesis_messages.InstanceGroupEvent {
+	var messages []proto.Message
+	require.Eventually(t, func() bool {
+		messages = queue.GetMessages()
+		return len(messages) > 0
+	}, time.Second, 10*time.Millisecond)
+	require.Len(t, messages, 1)
+	return messages[0].(*genesis_messages.InstanceGroupEvent)
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/health"
+	"go.taskfleet.io/services/genesis/store"
+	"go.uber.org/zap"
+)
+
+// HealthMonitor periodically probes all running instances of a Genesis service. Instances which
+// remain unhealthy for a configurable period are terminated and a deletion event with
+// `REASON_UNHEALTHY` is published. The health monitor implements the `mercury.Runnable`
+// interface.
+type HealthMonitor struct {
+	service   *Service
+	prober    health.Prober
+	interval  time.Duration
+	threshold time.Duration
+
+	// Only accessed by a single goroutine, hence, does not need to be synchronized.
+	failingSince map[uuid.UUID]time.Time
+}
+
+// NewHealthMonitor creates a new health monitor which probes the running instances of the given
+// service at the provided interval. Each probe must complete within the interval. Instances are
+// terminated once they have been failing probes for at least the given threshold.
+func NewHealthMonitor(
+	service *Service, prober health.Prober, interval, threshold time.Duration,
+) *HealthMonitor {
+	return &HealthMonitor{
+		service:      service,
+		prober:       prober,
+		interval:     interval,
+		threshold:    threshold,
+		failingSince: map[uuid.UUID]time.Time{},
+	}
+}
+
+// Run probes instances at the configured interval until the context is cancelled. Failures of
+// individual runs are logged but do not cause the health monitor to exit.
+func (m *HealthMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := m.Check(ctx); err != nil {
+				zeus.Logger(ctx).Error("failed to check instance health", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Check probes all running instances once and terminates instances which have been unhealthy for
+// at least the configured threshold.
+func (m *HealthMonitor) Check(ctx context.Context) error {
+	instances, err := m.service.store.List(ctx, store.Filter{
+		Statuses: []store.Status{store.StatusRunning},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list running instances: %s", err)
+	}
+
+	now := time.Now()
+	failures, err := jack.ParallelSliceMap(ctx, instances,
+		func(ctx context.Context, instance store.Instance) (error, error) {
+			ctx, cancel := context.WithTimeout(ctx, m.interval)
+			defer cancel()
+			return m.prober.Probe(ctx, m.service.runningInstance(instance)), nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Instances which are not running anymore are implicitly removed from the failing instances
+	failingSince := map[uuid.UUID]time.Time{}
+	for i, instance := range instances {
+		if failures[i] == nil {
+			continue
+		}
+		logger := zeus.Logger(ctx).With(zap.Stringer("id", instance.ID))
+		since, ok := m.failingSince[instance.ID]
+		if !ok {
+			since = now
+		}
+		if now.Sub(since) < m.threshold {
+			logger.Warn("instance is unhealthy", zap.Error(failures[i]))
+			failingSince[instance.ID] = since
+			continue
+		}
+
+		logger.Info("terminating unhealthy instance", zap.Error(failures[i]))
+		deleted := &genesis_messages.InstanceDeletedEvent{
+			Reason: genesis_messages.InstanceDeletedEvent_REASON_UNHEALTHY,
+		}
+		if err := m.service.terminate(ctx, instance.ID, deleted); err != nil {
+			logger.Error("failed to terminate unhealthy instance", zap.Error(err))
+			failingSince[instance.ID] = since
+		}
+	}
+	m.failingSince = failingSince
+	return nil
+}
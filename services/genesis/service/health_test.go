@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant/memory"
+	"go.taskfleet.io/services/genesis/health"
+)
+
+func TestHealthMonitor(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue))
+
+	healthy := f.createRequest("owner")
+	unhealthy := f.createRequest("owner")
+	for _, req := range []*genesis.CreateInstanceRequest{healthy, unhealthy} {
+		_, err := f.client.CreateInstance(f.ctx, req)
+		require.Nil(t, err)
+	}
+	f.awaitRunning("owner", 2)
+	queue.GetMessages()
+
+	prober := health.ProberFunc(func(ctx context.Context, i *genesis.RunningInstance) error {
+		if i.Instance.Id == unhealthy.Id {
+			return fmt.Errorf("not serving")
+		}
+		return nil
+	})
+	monitor := NewHealthMonitor(f.service, prober, time.Second, 50*time.Millisecond)
+
+	// The instance must not be terminated before the threshold is exceeded
+	require.Nil(t, monitor.Check(f.ctx))
+	f.awaitRunning("owner", 2)
+
+	time.Sleep(50 * time.Millisecond)
+	require.Nil(t, monitor.Check(f.ctx))
+	running := f.awaitRunning("owner", 1)
+	assert.Equal(t, healthy.Id, running[0].Instance.Id)
+
+	event := awaitEvent(t, queue)
+	assert.Equal(t, unhealthy.Id, event.Instance.Id)
+	assert.Equal(t,
+		genesis_messages.InstanceDeletedEvent_REASON_UNHEALTHY, event.GetDeleted().GetReason(),
+	)
+}
+
+func TestHealthMonitorRecovery(t *testing.T) {
+	f := newServiceFixture(t)
+
+	_, err := f.client.CreateInstance(f.ctx, f.createRequest("owner"))
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+
+	failing := true
+	prober := health.ProberFunc(func(ctx context.Context, i *genesis.RunningInstance) error {
+		if failing {
+			return fmt.Errorf("not serving")
+		}
+		return nil
+	})
+	monitor := NewHealthMonitor(f.service, prober, time.Second, 50*time.Millisecond)
+
+	// An instance which recovers must not be terminated
+	require.Nil(t, monitor.Check(f.ctx))
+	failing = false
+	require.Nil(t, monitor.Check(f.ctx))
+	failing = true
+	time.Sleep(50 * time.Millisecond)
+	require.Nil(t, monitor.Check(f.ctx))
+	f.awaitRunning("owner", 1)
+}
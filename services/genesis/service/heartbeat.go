@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/health"
+	"go.taskfleet.io/services/genesis/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// InstanceHeartbeat implements the genesis.GenesisServiceServer interface.
+func (s *Service) InstanceHeartbeat(
+	ctx context.Context, req *genesis.InstanceHeartbeatRequest,
+) (*genesis.InstanceHeartbeatResponse, error) {
+	id := uuid.MustParse(req.Instance.Id)
+
+	// Heartbeats of pending instances are accepted as agents might start before the provider
+	// reports the instance to be running.
+	instance, err := s.store.Get(ctx, id)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, storeError(err)
+	}
+	if err != nil || !isActive(instance) {
+		return nil, status.Errorf(codes.NotFound, "instance %s does not exist", id)
+	}
+
+	s.heartbeats.record(id, req.Utilization)
+	return &genesis.InstanceHeartbeatResponse{}, nil
+}
+
+// HeartbeatProber returns a prober which deems instances unhealthy if no heartbeat has been
+// received from them within the given timeout. Instances which have not sent any heartbeat yet
+// are granted the timeout starting from their creation or, if later, the start of the service.
+func (s *Service) HeartbeatProber(timeout time.Duration) health.Prober {
+	return health.ProberFunc(func(ctx context.Context, instance *genesis.RunningInstance) error {
+		var lastSeen time.Time
+		if instance.LastSeen != nil {
+			lastSeen = instance.LastSeen.AsTime()
+		} else {
+			record, err := s.store.Get(ctx, uuid.MustParse(instance.Instance.Id))
+			if err != nil {
+				return fmt.Errorf("failed to get instance: %s", err)
+			}
+			lastSeen = record.CreatedAt
+			if s.startedAt.After(lastSeen) {
+				lastSeen = s.startedAt
+			}
+		}
+		if missing := time.Since(lastSeen); missing > timeout {
+			return fmt.Errorf("no heartbeat received for %s", missing.Round(time.Second))
+		}
+		return nil
+	})
+}
+
+//-------------------------------------------------------------------------------------------------
+// HEARTBEATS
+//-------------------------------------------------------------------------------------------------
+
+// idleUtilization is the CPU and GPU utilization below which instances are considered idle.
+const idleUtilization = 0.05
+
+type heartbeat struct {
+	time        time.Time
+	utilization *genesis.InstanceUtilization
+	// The time of the most recent heartbeat which reported that the instance is not idle.
+	activeAt time.Time
+}
+
+// heartbeatSet tracks the most recent heartbeat of all instances. Heartbeats are deliberately not
+// persisted: after a restart, instances are granted a grace period to send their next heartbeat.
+type heartbeatSet struct {
+	mutex      sync.RWMutex
+	heartbeats map[uuid.UUID]heartbeat
+}
+
+func newHeartbeatSet() *heartbeatSet {
+	return &heartbeatSet{heartbeats: map[uuid.UUID]heartbeat{}}
+}
+
+func (s *heartbeatSet) record(id uuid.UUID, utilization *genesis.InstanceUtilization) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	hb := heartbeat{time: time.Now(), utilization: utilization}
+	hb.activeAt = s.heartbeats[id].activeAt
+	if utilization.GetCpu() >= idleUtilization || utilization.GetGpu() >= idleUtilization {
+		hb.activeAt = hb.time
+	}
+	s.heartbeats[id] = hb
+}
+
+// activeAt returns the time at which the instance with the given ID was last reported to not be
+// idle. The time is zero if the instance has not been reported to be active.
+func (s *heartbeatSet) activeAt(id uuid.UUID) time.Time {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.heartbeats[id].activeAt
+}
+
+func (s *heartbeatSet) remove(id uuid.UUID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.heartbeats, id)
+}
+
+// decorate sets the last heartbeat of the given instance, if any.
+func (s *heartbeatSet) decorate(instance *genesis.RunningInstance) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if hb, ok := s.heartbeats[uuid.MustParse(instance.Instance.Id)]; ok {
+		instance.LastSeen = timestamppb.New(hb.time)
+		instance.Utilization = hb.utilization
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestInstanceHeartbeat(t *testing.T) {
+	f := newServiceFixture(t)
+
+	// Unknown instance
+	_, err := f.client.InstanceHeartbeat(f.ctx, &genesis.InstanceHeartbeatRequest{
+		Instance: &genesis.Instance{Id: uuid.NewString()},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Running instance
+	req := f.createRequest("owner")
+	_, err = f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	running := f.awaitRunning("owner", 1)
+	assert.Nil(t, running[0].LastSeen)
+
+	utilization := &genesis.InstanceUtilization{Cpu: 0.5, Memory: 0.25}
+	_, err = f.client.InstanceHeartbeat(f.ctx, &genesis.InstanceHeartbeatRequest{
+		Instance:    &genesis.Instance{Id: req.Id},
+		Utilization: utilization,
+	})
+	require.Nil(t, err)
+
+	running = f.awaitRunning("owner", 1)
+	require.NotNil(t, running[0].LastSeen)
+	assert.WithinDuration(t, time.Now(), running[0].LastSeen.AsTime(), time.Second)
+	assert.True(t, proto.Equal(utilization, running[0].Utilization))
+
+	// Invalid utilization
+	_, err = f.client.InstanceHeartbeat(f.ctx, &genesis.InstanceHeartbeatRequest{
+		Instance:    &genesis.Instance{Id: req.Id},
+		Utilization: &genesis.InstanceUtilization{Cpu: 2},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestHeartbeatProber(t *testing.T) {
+	f := newServiceFixture(t)
+	prober := f.service.HeartbeatProber(100 * time.Millisecond)
+
+	req := f.createRequest("owner")
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+
+	// Instances are granted the timeout for their first heartbeat
+	assert.Nil(t, prober.Probe(f.ctx, f.awaitRunning("owner", 1)[0]))
+	time.Sleep(100 * time.Millisecond)
+	assert.NotNil(t, prober.Probe(f.ctx, f.awaitRunning("owner", 1)[0]))
+
+	// Once a heartbeat is received, the instance is healthy again
+	_, err = f.client.InstanceHeartbeat(f.ctx, &genesis.InstanceHeartbeatRequest{
+		Instance: &genesis.Instance{Id: req.Id},
+	})
+	require.Nil(t, err)
+	assert.Nil(t, prober.Probe(f.ctx, f.awaitRunning("owner", 1)[0]))
+}
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/store"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateInstance implements the genesis.GenesisServiceServer interface.
+func (s *Service) CreateInstance(
+	ctx context.Context, req *genesis.CreateInstanceRequest,
+) (*genesis.CreateInstanceResponse, error) {
+	id := uuid.MustParse(req.Id)
+
+	// If the instance has been requested before, the request is a retry
+	existing, err := s.store.Get(ctx, id)
+	if err == nil {
+		return replayCreate(existing, req)
+	}
+	if !errors.Is(err, store.ErrNotFound) {
+		return nil, storeError(err)
+	}
+
+	// Register the instance as pending
+	instance, err := s.pendingInstance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.awaitRecovery(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.register(ctx, instance); err != nil {
+		if !errors.Is(err, store.ErrAlreadyExists) {
+			return nil, err
+		}
+		// A concurrent retry registered the instance in the meantime
+		existing, err := s.store.Get(ctx, id)
+		if err != nil {
+			return nil, storeError(err)
+		}
+		return replayCreate(existing, req)
+	}
+
+	// And schedule its creation
+	select {
+	case s.jobs <- s.creationJob(instance):
+	case <-ctx.Done():
+		if err := s.store.Delete(ctx, id); err != nil {
+			zeus.Logger(ctx).Error("failed to remove unscheduled instance",
+				zap.Stringer("id", id), zap.Error(err),
+			)
+		}
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return createResponse(instance), nil
+}
+
+// ListInstances implements the genesis.GenesisServiceServer interface.
+func (s *Service) ListInstances(
+	ctx context.Context, req *genesis.ListInstancesRequest,
+) (*genesis.ListInstancesResponse, error) {
+	instances, err := s.store.List(ctx, store.Filter{
+		Owner:         req.Owner,
+		Statuses:      []store.Status{store.StatusRunning},
+		Labels:        req.LabelSelector,
+		Component:     req.Component,
+		CloudProvider: req.CloudProvider,
+		Zone:          req.Zone,
+		GPUKind:       req.GpuKind,
+		IsSpot:        req.IsSpot,
+	})
+	if err != nil {
+		return nil, storeError(err)
+	}
+	page, nextPageToken, err := paginate(instances, req)
+	if err != nil {
+		return nil, err
+	}
+	return &genesis.ListInstancesResponse{
+		Instances:     jack.SliceMap(page, s.runningInstance),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ShutdownInstance implements the genesis.GenesisServiceServer interface.
+func (s *Service) ShutdownInstance(
+	ctx context.Context, req *genesis.ShutdownInstanceRequest,
+) (*genesis.ShutdownInstanceResponse, error) {
+	id := uuid.MustParse(req.Instance.Id)
+
+	instance, err := s.store.Get(ctx, id)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, storeError(err)
+	}
+	if err != nil || instance.Status == store.StatusFailed ||
+		instance.Status == store.StatusDeleted {
+		return nil, status.Errorf(codes.NotFound, "instance %s does not exist", id)
+	}
+	if instance.Status == store.StatusPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "instance %s is not running yet", id)
+	}
+
+	p := s.providers[instance.Config.CloudProvider]
+	if err := p.DeleteInstance(ctx, instance.Config.Zone, id); err != nil {
+		if !errors.Is(err, provider.ErrNotFound) {
+			return nil, status.Errorf(codes.Unavailable, "failed to delete instance: %s", err)
+		}
+		zeus.Logger(ctx).Warn("instance to shut down did not exist anymore", zap.Stringer("id", id))
+	}
+
+	deleted := &genesis_messages.InstanceDeletedEvent{
+		Reason: genesis_messages.InstanceDeletedEvent_REASON_SHUTDOWN,
+	}
+	if err := s.markDeleted(ctx, instance, deleted); err != nil {
+		return nil, storeError(err)
+	}
+	return &genesis.ShutdownInstanceResponse{}, nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// CREATION
+//-------------------------------------------------------------------------------------------------
+
+func (s *Service) creationJob(instance store.Instance) func(context.Context) {
+	return func(ctx context.Context) {
+		s.create(ctx, instance)
+	}
+}
+
+func (s *Service) create(ctx context.Context, instance store.Instance) {
+	logger := zeus.Logger(ctx).With(zap.Stringer("id", instance.ID))
+	created, err := func() (provider.Instance, error) {
+		spec, err := s.instanceSpec(instance)
+		if err != nil {
+			return provider.Instance{}, err
+		}
+		ctx, cancel := context.WithTimeout(ctx, s.creationTimeout)
+		defer cancel()
+		p := s.providers[instance.Config.CloudProvider]
+		return p.CreateInstance(ctx, spec)
+	}()
+	if err != nil {
+		if ctx.Err() != nil {
+			// The service is shutting down, the creation is resumed once the service restarts
+			logger.Warn("aborted instance creation due to shutdown")
+			return
+		}
+		logger.Error("failed to create instance", zap.Error(err))
+		s.markFailed(ctx, instance, err)
+		return
+	}
+	s.markRunning(ctx, instance, created)
+}
+
+// pendingInstance validates the given request and returns the pending instance which is to be
+// created for it. Returned errors are gRPC errors.
+func (s *Service) pendingInstance(
+	ctx context.Context, req *genesis.CreateInstanceRequest,
+) (store.Instance, error) {
+	if err := validateLabels(req.Labels); err != nil {
+		return store.Instance{}, err
+	}
+	if err := s.validateZone(ctx, req.Config, req.Resources); err != nil {
+		return store.Instance{}, err
+	}
+	if err := s.validateComponent(req.Component, req.Config, req.Resources); err != nil {
+		return store.Instance{}, err
+	}
+	machineType, resources, err := s.matchInstanceType(req)
+	if err != nil {
+		return store.Instance{}, err
+	}
+	return store.Instance{
+		ID:          uuid.MustParse(req.Id),
+		Owner:       req.Owner,
+		Component:   req.Component,
+		Request:     req,
+		Config:      req.Config,
+		MachineType: machineType,
+		Resources:   resources,
+		Status:      store.StatusPending,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// markRunning persists that the given instance is running as the provided instance and publishes
+// the corresponding event.
+func (s *Service) markRunning(
+	ctx context.Context, instance store.Instance, created provider.Instance,
+) {
+	logger := zeus.Logger(ctx).With(zap.Stringer("id", instance.ID))
+	instance.Status = store.StatusRunning
+	instance.Hostname = created.Hostname
+	if err := s.store.Update(ctx, instance); err != nil {
+		logger.Error("failed to persist running instance", zap.Error(err))
+	}
+	logger.Info("created instance", zap.String("hostname", created.Hostname))
+	s.publish(ctx, instance.Owner, instanceCreatedEvent(instance))
+}
+
+// markFailed persists that the given instance failed to be created due to the provided error and
+// publishes the corresponding event.
+func (s *Service) markFailed(ctx context.Context, instance store.Instance, err error) {
+	event := instanceCreationFailedEvent(instance.ID, err)
+	instance.Status = store.StatusFailed
+	instance.DeletedAt = time.Now()
+	instance.Failure = event.GetCreationFailed()
+	if err := s.store.Update(ctx, instance); err != nil {
+		zeus.Logger(ctx).Error("failed to persist failed instance",
+			zap.Stringer("id", instance.ID), zap.Error(err),
+		)
+	}
+	s.heartbeats.remove(instance.ID)
+	s.publish(ctx, instance.Owner, event)
+}
+
+// markDeleted persists that the given running instance was deleted and publishes the provided
+// deletion event. The transition is conditional on the instance still being running: if another
+// caller marked the instance as deleted concurrently, this is a noop such that exactly one
+// deletion event is published.
+func (s *Service) markDeleted(
+	ctx context.Context,
+	instance store.Instance,
+	deleted *genesis_messages.InstanceDeletedEvent,
+) error {
+	instance.Status = store.StatusDeleted
+	instance.DeletedAt = time.Now()
+	if err := s.store.UpdateIf(ctx, instance, store.StatusRunning); err != nil {
+		if errors.Is(err, store.ErrStatusChanged) {
+			zeus.Logger(ctx).Debug("instance was deleted concurrently",
+				zap.Stringer("id", instance.ID),
+			)
+			return nil
+		}
+		return err
+	}
+	s.heartbeats.remove(instance.ID)
+	s.publish(ctx, instance.Owner, instanceDeletedEvent(instance.ID, deleted))
+	return nil
+}
+
+// terminate deletes the running instance with the specified ID at its provider, marks it as
+// deleted and publishes the provided deletion event. If the instance is not running anymore, this
+// is a noop.
+func (s *Service) terminate(
+	ctx context.Context, id uuid.UUID, deleted *genesis_messages.InstanceDeletedEvent,
+) error {
+	// The status of the instance needs to be checked again as it might have changed concurrently
+	instance, err := s.store.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get instance: %s", err)
+	}
+	if instance.Status != store.StatusRunning {
+		return nil
+	}
+
+	p := s.providers[instance.Config.CloudProvider]
+	if err := p.DeleteInstance(ctx, instance.Config.Zone, id); err != nil &&
+		!errors.Is(err, provider.ErrNotFound) {
+		return fmt.Errorf("failed to delete instance: %s", err)
+	}
+	if err := s.markDeleted(ctx, instance, deleted); err != nil {
+		return fmt.Errorf("failed to mark instance as deleted: %s", err)
+	}
+	return nil
+}
+
+// recoverPending handles all instances which are pending according to the store. This is the case
+// if the service was stopped while instances were being created. Instances which have been
+// created by their provider in the meantime are marked as running. For all other instances, the
+// returned jobs resume the creation. Instances of groups are handled by the jobs of their groups.
+func (s *Service) recoverPending(ctx context.Context) ([]func(context.Context), error) {
+	pending, err := s.store.List(ctx, store.Filter{
+		Statuses: []store.Status{store.StatusPending},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending instances: %s", err)
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	existing := map[uuid.UUID]provider.Instance{}
+	for cloud, p := range s.providers {
+		instances, err := p.ListInstances(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instances of %s: %s", cloud, err)
+		}
+		for _, instance := range instances {
+			existing[instance.ID] = instance
+		}
+	}
+
+	jobs := []func(context.Context){}
+	groups := map[uuid.UUID][]store.Instance{}
+	for _, instance := range pending {
+		if instance.GroupID != uuid.Nil {
+			groups[instance.GroupID] = append(groups[instance.GroupID], instance)
+			continue
+		}
+		if created, ok := existing[instance.ID]; ok {
+			s.markRunning(ctx, instance, created)
+			continue
+		}
+		zeus.Logger(ctx).Info("resuming instance creation", zap.Stringer("id", instance.ID))
+		jobs = append(jobs, s.creationJob(instance))
+	}
+
+	// Groups are always recovered as a whole to retain atomicity
+	for id, instances := range groups {
+		zeus.Logger(ctx).Info("resuming instance group creation", zap.Stringer("group", id))
+		job, err := s.recoverGroup(ctx, instances, existing)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// UTILITIES
+//-------------------------------------------------------------------------------------------------
+
+// runningInstances returns all running instances of the given owner, ordered by their creation
+// time.
+func (s *Service) runningInstances(
+	ctx context.Context, owner string,
+) ([]*genesis.RunningInstance, error) {
+	instances, err := s.store.List(ctx, store.Filter{
+		Owner:    owner,
+		Statuses: []store.Status{store.StatusRunning},
+	})
+	if err != nil {
+		return nil, storeError(err)
+	}
+	return jack.SliceMap(instances, s.runningInstance), nil
+}
+
+func createResponse(instance store.Instance) *genesis.CreateInstanceResponse {
+	return &genesis.CreateInstanceResponse{
+		Instance:  &genesis.Instance{Id: instance.ID.String()},
+		Config:    instance.Config,
+		Resources: instance.Resources,
+	}
+}
+
+// replayCreate returns the response of the original request that created the given instance if
+// the provided request is a retry of that request. Otherwise, it returns an error indicating that
+// the instance already exists. If the creation of the instance failed, the returned error carries
+// the failure.
+func replayCreate(
+	instance store.Instance, req *genesis.CreateInstanceRequest,
+) (*genesis.CreateInstanceResponse, error) {
+	if !proto.Equal(instance.Request, req) {
+		return nil, status.Errorf(codes.AlreadyExists,
+			"instance %s was already requested with a different specification", instance.ID,
+		)
+	}
+	if instance.Status == store.StatusFailed {
+		// The failure is attached such that clients do not need to wait for the (already
+		// published) event
+		failure := instance.Failure
+		if failure == nil {
+			failure = &genesis_messages.InstanceCreationFailedEvent{}
+		}
+		st := status.Newf(codes.FailedPrecondition,
+			"creation of instance %s failed: %s", instance.ID, failure.Message,
+		)
+		if detailed, err := st.WithDetails(failure); err == nil {
+			st = detailed
+		}
+		return nil, st.Err()
+	}
+	return createResponse(instance), nil
+}
+
+func (s *Service) runningInstance(instance store.Instance) *genesis.RunningInstance {
+	result := &genesis.RunningInstance{
+		Instance:  &genesis.Instance{Id: instance.ID.String()},
+		Component: instance.Component,
+		Config:    instance.Config,
+		Resources: instance.Resources,
+		Hostname:  instance.Hostname,
+		Labels:    instance.Request.GetLabels(),
+	}
+	if instance.GroupID != uuid.Nil {
+		result.Group = &genesis.InstanceGroup{Id: instance.GroupID.String()}
+	}
+	s.heartbeats.decorate(result)
+	return result
+}
+
+func storeError(err error) error {
+	return status.Errorf(codes.Internal, "failed to access instance store: %s", err)
+}
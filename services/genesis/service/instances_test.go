@@ -0,0 +1,558 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant/memory"
+	"go.taskfleet.io/packages/eagle"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/catalog"
+	"go.taskfleet.io/services/genesis/component"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/provider/fake"
+	"go.taskfleet.io/services/genesis/quota"
+	"go.taskfleet.io/services/genesis/store"
+	memorystore "go.taskfleet.io/services/genesis/store/memory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCreateInstance(t *testing.T) {
+	f := newServiceFixture(t)
+
+	req := f.createRequest("owner")
+	response, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	assert.Equal(t, req.Id, response.Instance.Id)
+	assert.True(t, proto.Equal(req.Config, response.Config))
+	assert.True(t, proto.Equal(req.Resources, response.Resources))
+
+	instances := f.awaitRunning("owner", 1)
+	assert.Equal(t, req.Id, instances[0].Instance.Id)
+	assert.Equal(t, "worker", instances[0].Component)
+	assert.NotEmpty(t, instances[0].Hostname)
+
+	created, ok := f.provider.Instance(uuid.MustParse(req.Id))
+	require.True(t, ok)
+	assert.Equal(t, "owner", created.Tags[provider.TagOwner])
+	assert.Equal(t, "worker", created.Tags[provider.TagComponent])
+}
+
+func TestCreateInstanceInvalid(t *testing.T) {
+	f := newServiceFixture(t)
+
+	// Missing configuration
+	req := f.createRequest("owner")
+	req.Config = nil
+	_, err := f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Disabled cloud provider
+	req = f.createRequest("owner")
+	req.Config.CloudProvider = genesis.CloudProvider_CLOUD_PROVIDER_AMAZON_WEB_SERVICES
+	_, err = f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Unknown zone
+	req = f.createRequest("owner")
+	req.Config.Zone = "unknown"
+	_, err = f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Unavailable GPU
+	req = f.createRequest("owner")
+	req.Config.Zone = "us-east1-c"
+	_, err = f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateInstanceFailure(t *testing.T) {
+	f := newServiceFixture(t)
+	f.provider.SetCreateHook(func(ctx context.Context, spec provider.InstanceSpec) error {
+		return fmt.Errorf("failed: %w", provider.ErrInsufficientResources)
+	})
+
+	req := f.createRequest("owner")
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+
+	// The failed instance is never running and cannot be shut down
+	assert.Eventually(t, func() bool {
+		_, err := f.client.ShutdownInstance(f.ctx, &genesis.ShutdownInstanceRequest{
+			Instance: &genesis.Instance{Id: req.Id},
+		})
+		return status.Code(err) == codes.NotFound
+	}, time.Second, 10*time.Millisecond)
+	f.awaitRunning("owner", 0)
+
+	// Retries must report the failure
+	_, err = f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Len(t, status.Convert(err).Details(), 1)
+	failure := status.Convert(err).Details()[0].(*genesis_messages.InstanceCreationFailedEvent)
+	assert.Equal(t,
+		genesis_messages.InstanceCreationFailedEvent_REASON_INSUFFICIENT_RESOURCES, failure.Reason,
+	)
+}
+
+func TestCreateInstanceIdempotent(t *testing.T) {
+	f := newServiceFixture(t)
+	var creations atomic.Int32
+	f.provider.SetCreateHook(func(ctx context.Context, spec provider.InstanceSpec) error {
+		creations.Add(1)
+		return nil
+	})
+
+	// Retrying the same request returns the original response
+	req := f.createRequest("owner")
+	first, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	second, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(first, second))
+
+	// Also once the instance is running
+	f.awaitRunning("owner", 1)
+	third, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	assert.True(t, proto.Equal(first, third))
+	assert.EqualValues(t, 1, creations.Load())
+
+	// Requests with the same ID but a different specification are rejected
+	changed := proto.Clone(req).(*genesis.CreateInstanceRequest)
+	changed.Resources.CpuCount = 8
+	_, err = f.client.CreateInstance(f.ctx, changed)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	changed = proto.Clone(req).(*genesis.CreateInstanceRequest)
+	changed.Owner = "other"
+	_, err = f.client.CreateInstance(f.ctx, changed)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestListInstancesExcludesPending(t *testing.T) {
+	f := newServiceFixture(t)
+	release := make(chan struct{})
+	f.provider.SetCreateHook(func(ctx context.Context, spec provider.InstanceSpec) error {
+		<-release
+		return nil
+	})
+
+	_, err := f.client.CreateInstance(f.ctx, f.createRequest("owner"))
+	require.Nil(t, err)
+
+	response, err := f.client.ListInstances(f.ctx, &genesis.ListInstancesRequest{Owner: "owner"})
+	require.Nil(t, err)
+	assert.Len(t, response.Instances, 0)
+
+	close(release)
+	f.awaitRunning("owner", 1)
+}
+
+func TestListInstancesByOwner(t *testing.T) {
+	f := newServiceFixture(t)
+
+	first := f.createRequest("first")
+	second := f.createRequest("second")
+	for _, req := range []*genesis.CreateInstanceRequest{first, second} {
+		_, err := f.client.CreateInstance(f.ctx, req)
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, []string{first.Id}, f.instanceIDs(f.awaitRunning("first", 1)))
+	assert.Equal(t, []string{second.Id}, f.instanceIDs(f.awaitRunning("second", 1)))
+}
+
+func TestListInstancesPagination(t *testing.T) {
+	f := newServiceFixture(t)
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		req := f.createRequest("owner")
+		_, err := f.client.CreateInstance(f.ctx, req)
+		require.Nil(t, err)
+		ids = append(ids, req.Id)
+	}
+	assert.Equal(t, ids, f.instanceIDs(f.awaitRunning("owner", 5)))
+
+	// Pages must be returned in order until all instances have been listed
+	req := &genesis.ListInstancesRequest{Owner: "owner", PageSize: 2}
+	var listed []string
+	for {
+		response, err := f.client.ListInstances(f.ctx, req)
+		require.Nil(t, err)
+		assert.LessOrEqual(t, len(response.Instances), 2)
+		listed = append(listed, f.instanceIDs(response.Instances)...)
+		if response.NextPageToken == "" {
+			break
+		}
+		req.PageToken = response.NextPageToken
+	}
+	assert.Equal(t, ids, listed)
+
+	// Page tokens must not be reused with different filters
+	response, err := f.client.ListInstances(f.ctx, &genesis.ListInstancesRequest{
+		Owner: "owner", PageSize: 2,
+	})
+	require.Nil(t, err)
+	_, err = f.client.ListInstances(f.ctx, &genesis.ListInstancesRequest{
+		Owner: "owner", Component: "worker", PageToken: response.NextPageToken,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = f.client.ListInstances(f.ctx, &genesis.ListInstancesRequest{
+		Owner: "owner", PageToken: "invalid",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListInstancesFilters(t *testing.T) {
+	f := newServiceFixture(t)
+
+	gpu := f.createRequest("owner")
+	spot := f.createRequest("owner")
+	spot.Component = "scheduler"
+	spot.Config.Zone = "us-east1-c"
+	spot.Config.IsSpot = true
+	spot.Resources.Gpu = nil
+	for _, req := range []*genesis.CreateInstanceRequest{gpu, spot} {
+		_, err := f.client.CreateInstance(f.ctx, req)
+		require.Nil(t, err)
+	}
+	f.awaitRunning("owner", 2)
+
+	list := func(req *genesis.ListInstancesRequest) []string {
+		req.Owner = "owner"
+		response, err := f.client.ListInstances(f.ctx, req)
+		require.Nil(t, err)
+		return f.instanceIDs(response.Instances)
+	}
+	assert.Equal(t, []string{spot.Id}, list(&genesis.ListInstancesRequest{
+		Component: "scheduler",
+	}))
+	assert.Equal(t, []string{gpu.Id}, list(&genesis.ListInstancesRequest{
+		Zone: "europe-west1-b",
+	}))
+	assert.Equal(t, []string{gpu.Id}, list(&genesis.ListInstancesRequest{
+		GpuKind: genesis.GPUKind_GPU_KIND_TESLA_T4,
+	}))
+	assert.Equal(t, []string{spot.Id}, list(&genesis.ListInstancesRequest{
+		IsSpot: jack.Ptr(true),
+	}))
+	assert.Equal(t, []string{gpu.Id}, list(&genesis.ListInstancesRequest{
+		IsSpot: jack.Ptr(false),
+	}))
+	assert.Empty(t, list(&genesis.ListInstancesRequest{
+		CloudProvider: genesis.CloudProvider_CLOUD_PROVIDER_AMAZON_WEB_SERVICES,
+	}))
+}
+
+func TestCreateInstanceLabels(t *testing.T) {
+	f := newServiceFixture(t)
+
+	labeled := f.createRequest("owner")
+	labeled.Labels = map[string]string{"job": "training-42"}
+	_, err := f.client.CreateInstance(f.ctx, labeled)
+	require.Nil(t, err)
+	_, err = f.client.CreateInstance(f.ctx, f.createRequest("owner"))
+	require.Nil(t, err)
+	f.awaitRunning("owner", 2)
+
+	// Labels must be propagated to the provider and reported for the instance
+	instance, ok := f.provider.Instance(uuid.MustParse(labeled.Id))
+	require.True(t, ok)
+	assert.Equal(t, "training-42", instance.Tags["job"])
+
+	// Instances must be selectable by their labels
+	response, err := f.client.ListInstances(f.ctx, &genesis.ListInstancesRequest{
+		Owner:         "owner",
+		LabelSelector: map[string]string{"job": "training-42"},
+	})
+	require.Nil(t, err)
+	require.Len(t, response.Instances, 1)
+	assert.Equal(t, labeled.Id, response.Instances[0].Instance.Id)
+	assert.Equal(t, labeled.Labels, response.Instances[0].Labels)
+
+	// Invalid labels must be rejected
+	for _, labels := range []map[string]string{
+		{"Job": "training"},
+		{"job": "Training"},
+		{provider.TagOwner: "other"},
+	} {
+		req := f.createRequest("owner")
+		req.Labels = labels
+		_, err := f.client.CreateInstance(f.ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestShutdownInstance(t *testing.T) {
+	f := newServiceFixture(t)
+
+	// Unknown instance
+	_, err := f.client.ShutdownInstance(f.ctx, &genesis.ShutdownInstanceRequest{
+		Instance: &genesis.Instance{Id: uuid.NewString()},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Running instance
+	req := f.createRequest("owner")
+	_, err = f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+
+	_, err = f.client.ShutdownInstance(f.ctx, &genesis.ShutdownInstanceRequest{
+		Instance: &genesis.Instance{Id: req.Id},
+	})
+	require.Nil(t, err)
+	f.awaitRunning("owner", 0)
+
+	_, ok := f.provider.Instance(uuid.MustParse(req.Id))
+	assert.False(t, ok)
+}
+
+func TestRecoverPendingInstances(t *testing.T) {
+	ctx, cancel := context.WithCancel(zeus.WithNopLogger(context.Background()))
+	defer cancel()
+
+	// Simulate a restart where one instance was created and another one was not
+	f := &serviceFixture{t: t}
+	instanceStore := memorystore.NewStore()
+	created := pendingInstance(f.createRequest("owner"))
+	require.Nil(t, instanceStore.Create(ctx, created))
+	missing := pendingInstance(f.createRequest("owner"))
+	require.Nil(t, instanceStore.Create(ctx, missing))
+
+	fakeProvider := fake.NewProvider(genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		fixtureZones...,
+	)
+	fakeProvider.AddInstance(provider.Instance{
+		ID: created.ID, Zone: created.Config.Zone, Hostname: "recovered.fake.internal",
+	})
+	service, err := NewService([]provider.Provider{fakeProvider}, WithStore(instanceStore))
+	require.Nil(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.Run(ctx) // nolint:errcheck
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// Both instances must eventually be running
+	require.Eventually(t, func() bool {
+		running, err := instanceStore.List(ctx, store.Filter{
+			Statuses: []store.Status{store.StatusRunning},
+		})
+		require.Nil(t, err)
+		return len(running) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	instance, err := instanceStore.Get(ctx, created.ID)
+	require.Nil(t, err)
+	assert.Equal(t, "recovered.fake.internal", instance.Hostname)
+	_, ok := fakeProvider.Instance(missing.ID)
+	assert.True(t, ok)
+}
+
+func TestCreateInstanceBeforeRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(zeus.WithNopLogger(context.Background()))
+	defer cancel()
+
+	f := &serviceFixture{t: t}
+	queue := memory.NewQueue(10)
+	defer queue.Close()
+	fakeProvider := fake.NewProvider(genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		fixtureZones...,
+	)
+	service, err := NewService([]provider.Provider{fakeProvider}, WithPublisher(queue))
+	require.Nil(t, err)
+
+	// Creations are only accepted once pending instances have been recovered
+	req := f.createRequest("owner")
+	created := make(chan error, 1)
+	go func() {
+		_, err := service.CreateInstance(ctx, req)
+		created <- err
+	}()
+	select {
+	case <-created:
+		require.Fail(t, "instance creation was accepted before recovery")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.Run(ctx) // nolint:errcheck
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+	require.Nil(t, <-created)
+
+	// The instance must be created exactly once
+	event := awaitEvent(t, queue)
+	assert.NotNil(t, event.GetCreated())
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, queue.GetMessages(), 0)
+	instance, err := service.store.Get(ctx, uuid.MustParse(req.Id))
+	require.Nil(t, err)
+	assert.Equal(t, store.StatusRunning, instance.Status)
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func pendingInstance(req *genesis.CreateInstanceRequest) store.Instance {
+	return store.Instance{
+		ID:        uuid.MustParse(req.Id),
+		Owner:     req.Owner,
+		Component: req.Component,
+		Request:   req,
+		Config:    req.Config,
+		Resources: req.Resources,
+		Status:    store.StatusPending,
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestCreateInstanceWithCatalog(t *testing.T) {
+	c, err := catalog.NewCatalog(catalog.Config{Providers: []catalog.ProviderConfig{{
+		CloudProvider: catalog.CloudProvider(
+			genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		),
+		InstanceTypes: []catalog.InstanceType{{
+			Name:     "n1-standard-8-t4",
+			CPUCount: 8,
+			Memory:   30720,
+			GPU: &catalog.GPU{
+				Kind:  catalog.GPUKind(genesis.GPUKind_GPU_KIND_TESLA_T4),
+				Count: 1,
+			},
+			Price: catalog.Price{OnDemand: 0.73},
+		}},
+	}}})
+	require.Nil(t, err)
+	f := newServiceFixture(t, WithCatalog(c))
+
+	// The actual resources of the instance type must be returned
+	req := f.createRequest("owner")
+	response, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(8), response.Resources.CpuCount)
+	assert.Equal(t, uint32(30720), response.Resources.Memory)
+	assert.Equal(t, uint32(1), response.Resources.Gpu.Count)
+
+	running := f.awaitRunning("owner", 1)
+	assert.True(t, proto.Equal(response.Resources, running[0].Resources))
+	instance, ok := f.provider.Instance(uuid.MustParse(req.Id))
+	require.True(t, ok)
+	assert.Equal(t, "n1-standard-8-t4", instance.MachineType)
+
+	// Requests which cannot be satisfied must be rejected
+	req = f.createRequest("owner")
+	req.Resources.CpuCount = 16
+	_, err = f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateInstanceQuota(t *testing.T) {
+	maxInstances := uint32(1)
+	enforcer, err := quota.NewEnforcer(quota.Config{Owners: map[string]quota.Limits{
+		"owner": {MaxInstances: &maxInstances},
+	}})
+	require.Nil(t, err)
+	f := newServiceFixture(t, WithQuotas(enforcer))
+
+	first := f.createRequest("owner")
+	_, err = f.client.CreateInstance(f.ctx, first)
+	require.Nil(t, err)
+
+	// Further instances must be rejected while retries must succeed
+	_, err = f.client.CreateInstance(f.ctx, f.createRequest("owner"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = f.client.CreateInstance(f.ctx, first)
+	assert.Nil(t, err)
+	_, err = f.client.CreateInstance(f.ctx, f.createRequest("other"))
+	assert.Nil(t, err)
+
+	// Once the instance is shut down, another instance may be created
+	f.awaitRunning("owner", 1)
+	_, err = f.client.ShutdownInstance(f.ctx, &genesis.ShutdownInstanceRequest{
+		Instance: &genesis.Instance{Id: first.Id},
+	})
+	require.Nil(t, err)
+	_, err = f.client.CreateInstance(f.ctx, f.createRequest("owner"))
+	assert.Nil(t, err)
+}
+
+func TestCreateInstanceWithComponents(t *testing.T) {
+	components := map[string]component.Component{
+		"worker": {
+			Image:         "worker-v1",
+			StartupScript: eagle.NewString("#!/bin/sh"),
+			DiskSize:      100,
+			Labels:        map[string]string{"team": "ml"},
+			Zones:         []string{"europe-west1-b"},
+		},
+		"trainer": {
+			Image:    "trainer-v1",
+			GPUKinds: []catalog.GPUKind{catalog.GPUKind(genesis.GPUKind_GPU_KIND_TESLA_A100)},
+		},
+	}
+	registry, err := component.NewRegistry(component.Config{Components: components})
+	require.Nil(t, err)
+	f := newServiceFixture(t, WithComponents(registry))
+
+	specs := make(chan provider.InstanceSpec, 1)
+	f.provider.SetCreateHook(func(ctx context.Context, spec provider.InstanceSpec) error {
+		specs <- spec
+		return nil
+	})
+
+	// The instance must be created according to the component's configuration
+	_, err = f.client.CreateInstance(f.ctx, f.createRequest("owner"))
+	require.Nil(t, err)
+	spec := <-specs
+	assert.Equal(t, "worker-v1", spec.Image)
+	assert.Equal(t, "#!/bin/sh", spec.StartupScript)
+	assert.Equal(t, uint32(100), spec.DiskSize)
+	assert.Equal(t, map[string]string{
+		"team":                "ml",
+		provider.TagOwner:     "owner",
+		provider.TagComponent: "worker",
+	}, spec.Tags)
+
+	// Unknown components must be rejected
+	req := f.createRequest("owner")
+	req.Component = "unknown"
+	_, err = f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Zones that are not allowed must be rejected
+	req = f.createRequest("owner")
+	req.Config.Zone = "us-east1-c"
+	req.Resources.Gpu = nil
+	_, err = f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// GPUs that are not allowed must be rejected
+	req = f.createRequest("owner")
+	req.Component = "trainer"
+	_, err = f.client.CreateInstance(f.ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
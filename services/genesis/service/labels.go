@@ -0,0 +1,31 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	"go.taskfleet.io/services/genesis/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// labelValuePattern describes valid label values. Keys are validated by request validation, the
+// validation rules of values cannot be expressed alongside the rules of keys, however.
+var labelValuePattern = regexp.MustCompile("^[a-z0-9_-]*$")
+
+// validateLabels ensures that the given user-defined labels can be attached to instances as tags.
+func validateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if strings.HasPrefix(key, provider.TagPrefix) {
+			return status.Errorf(codes.InvalidArgument,
+				"label %q uses reserved prefix %q", key, provider.TagPrefix,
+			)
+		}
+		if !labelValuePattern.MatchString(value) {
+			return status.Errorf(codes.InvalidArgument,
+				"value of label %q must only contain lowercase letters, digits, '-' and '_'", key,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"time"
+
+	"go.taskfleet.io/packages/dymant"
+	"go.taskfleet.io/services/genesis/catalog"
+	"go.taskfleet.io/services/genesis/component"
+	"go.taskfleet.io/services/genesis/quota"
+	"go.taskfleet.io/services/genesis/store"
+)
+
+// Option allows to customize the Genesis service.
+type Option interface {
+	apply(s *Service)
+}
+
+//-------------------------------------------------------------------------------------------------
+// CREATION TIMEOUT
+//-------------------------------------------------------------------------------------------------
+
+type optionCreationTimeout struct {
+	timeout time.Duration
+}
+
+// WithCreationTimeout sets the maximum duration that a provider may take to create an instance.
+// If the timeout is exceeded, instance creation is considered to have failed. If this option is
+// not set, the timeout defaults to 10 minutes.
+func WithCreationTimeout(timeout time.Duration) Option {
+	return optionCreationTimeout{timeout}
+}
+
+func (o optionCreationTimeout) apply(s *Service) {
+	s.creationTimeout = o.timeout
+}
+
+//-------------------------------------------------------------------------------------------------
+// PUBLISHER
+//-------------------------------------------------------------------------------------------------
+
+type optionPublisher struct {
+	publisher dymant.Publisher
+}
+
+// WithPublisher sets the publisher to which the service publishes instance lifecycle events of
+// type `genesis_messages.InstanceEvent`. Events are keyed by the instance ID. If this option is
+// not set, no events are published.
+func WithPublisher(publisher dymant.Publisher) Option {
+	return optionPublisher{publisher}
+}
+
+func (o optionPublisher) apply(s *Service) {
+	s.publisher = o.publisher
+}
+
+type optionGroupPublisher struct {
+	publisher dymant.Publisher
+}
+
+// WithGroupPublisher sets the publisher to which the service publishes lifecycle events of
+// instance groups of type `genesis_messages.InstanceGroupEvent`. Events are keyed by the group ID.
+// If this option is not set, no group events are published.
+func WithGroupPublisher(publisher dymant.Publisher) Option {
+	return optionGroupPublisher{publisher}
+}
+
+func (o optionGroupPublisher) apply(s *Service) {
+	s.groupPublisher = o.publisher
+}
+
+//-------------------------------------------------------------------------------------------------
+// STORE
+//-------------------------------------------------------------------------------------------------
+
+type optionStore struct {
+	store store.Store
+}
+
+// WithStore sets the store in which the service persists the instances it manages. If this option
+// is not set, instances are only kept in memory and the service cannot recover its state after a
+// restart.
+func WithStore(store store.Store) Option {
+	return optionStore{store}
+}
+
+func (o optionStore) apply(s *Service) {
+	s.store = o.store
+}
+
+//-------------------------------------------------------------------------------------------------
+// CATALOG
+//-------------------------------------------------------------------------------------------------
+
+type optionCatalog struct {
+	catalog *catalog.Catalog
+}
+
+// WithCatalog sets the catalog of instance types from which the service chooses the machine type
+// of new instances. For each instance, the cheapest instance type satisfying the requested
+// resources is chosen and the actual resources of the instance type are returned. Further, the
+// zones configured in the catalog are merged with the zones discovered from the providers. If this
+// option is not set, providers choose machine types themselves and the requested resources are
+// returned.
+func WithCatalog(catalog *catalog.Catalog) Option {
+	return optionCatalog{catalog}
+}
+
+func (o optionCatalog) apply(s *Service) {
+	s.catalog = o.catalog
+}
+
+//-------------------------------------------------------------------------------------------------
+// ZONE CACHE TTL
+//-------------------------------------------------------------------------------------------------
+
+type optionZoneCacheTTL struct {
+	ttl time.Duration
+}
+
+// WithZoneCacheTTL sets the duration for which the zones discovered from providers are cached. If
+// this option is not set, zones are cached for 5 minutes.
+func WithZoneCacheTTL(ttl time.Duration) Option {
+	return optionZoneCacheTTL{ttl}
+}
+
+func (o optionZoneCacheTTL) apply(s *Service) {
+	s.zoneCacheTTL = o.ttl
+}
+
+//-------------------------------------------------------------------------------------------------
+// COMPONENTS
+//-------------------------------------------------------------------------------------------------
+
+type optionComponents struct {
+	registry *component.Registry
+}
+
+// WithComponents sets the registry which provides the configurations of components. Requests for
+// components without configuration as well as requests for zones or GPUs that are not allowed for
+// a component are rejected with `INVALID_ARGUMENT`. Instances are created with the image, startup
+// script, disk size and labels of their component. If this option is not set, instances of any
+// component are created with the providers' defaults.
+func WithComponents(registry *component.Registry) Option {
+	return optionComponents{registry}
+}
+
+func (o optionComponents) apply(s *Service) {
+	s.components = o.registry
+}
+
+//-------------------------------------------------------------------------------------------------
+// PREEMPTION POLICY
+//-------------------------------------------------------------------------------------------------
+
+type optionPreemptionPolicy struct {
+	component string
+	policy    PreemptionPolicy
+}
+
+// WithPreemptionPolicy sets the policy for handling preempted spot instances of the given
+// component. If no policy is set for a component, its preempted instances are not replaced.
+func WithPreemptionPolicy(component string, policy PreemptionPolicy) Option {
+	return optionPreemptionPolicy{component, policy}
+}
+
+func (o optionPreemptionPolicy) apply(s *Service) {
+	s.preemptionPolicies[o.component] = o.policy
+}
+
+//-------------------------------------------------------------------------------------------------
+// QUOTAS
+//-------------------------------------------------------------------------------------------------
+
+type optionQuotas struct {
+	enforcer *quota.Enforcer
+}
+
+// WithQuotas enforces the quotas of the given enforcer whenever an instance is requested. All
+// pending and running instances count towards quotas. Requests exceeding a quota are rejected with
+// `RESOURCE_EXHAUSTED`. If this option is not set, no quotas are enforced.
+func WithQuotas(enforcer *quota.Enforcer) Option {
+	return optionQuotas{enforcer}
+}
+
+func (o optionQuotas) apply(s *Service) {
+	s.quotas = o.enforcer
+}
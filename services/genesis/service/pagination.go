@@ -0,0 +1,109 @@
+package service
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"hash/fnv"
+	"time"
+
+	"github.com/google/uuid"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultPageSize is the number of instances returned by `ListInstances` if no page size is set.
+const defaultPageSize = 100
+
+// pageToken describes the position after which the next page of instances starts. As instances
+// are ordered by their creation time and ID, the position remains valid if instances are added or
+// removed between requests.
+type pageToken struct {
+	// The creation time of the last instance of the previous page.
+	CreatedAt time.Time `json:"createdAt"`
+	// The ID of the last instance of the previous page.
+	ID uuid.UUID `json:"id"`
+	// A fingerprint of the request that returned the token.
+	Fingerprint uint64 `json:"fingerprint"`
+}
+
+// paginate returns the page of the given instances requested by the provided request along with
+// the token for the next page. Instances must be sorted by their creation time.
+func paginate(
+	instances []store.Instance, req *genesis.ListInstancesRequest,
+) ([]store.Instance, string, error) {
+	fingerprint := requestFingerprint(req)
+
+	// Skip all instances up to the position of the token
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken)
+		if err != nil || token.Fingerprint != fingerprint {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid page token")
+		}
+		start := len(instances)
+		for i, instance := range instances {
+			if isAfter(instance, token) {
+				start = i
+				break
+			}
+		}
+		instances = instances[start:]
+	}
+
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if len(instances) <= pageSize {
+		return instances, "", nil
+	}
+	instances = instances[:pageSize]
+	last := instances[len(instances)-1]
+	return instances, encodePageToken(pageToken{
+		CreatedAt:   last.CreatedAt,
+		ID:          last.ID,
+		Fingerprint: fingerprint,
+	}), nil
+}
+
+// isAfter returns whether the instance is ordered after the position of the given token, see
+// `store.SortByCreation`.
+func isAfter(instance store.Instance, token pageToken) bool {
+	if instance.CreatedAt.Equal(token.CreatedAt) {
+		return bytes.Compare(instance.ID[:], token.ID[:]) > 0
+	}
+	return instance.CreatedAt.After(token.CreatedAt)
+}
+
+// requestFingerprint computes a fingerprint of all fields of the request that determine the set
+// of returned instances.
+func requestFingerprint(req *genesis.ListInstancesRequest) uint64 {
+	filter := proto.Clone(req).(*genesis.ListInstancesRequest)
+	filter.PageSize = 0
+	filter.PageToken = ""
+	data := jack.Must(proto.MarshalOptions{Deterministic: true}.Marshal(filter))
+	hash := fnv.New64a()
+	hash.Write(data) // nolint:errcheck
+	return hash.Sum64()
+}
+
+func encodePageToken(token pageToken) string {
+	data := jack.Must(json.Marshal(token))
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(value string) (pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return pageToken{}, err
+	}
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return pageToken{}, err
+	}
+	return token, nil
+}
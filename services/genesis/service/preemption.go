@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/store"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// PreemptionPolicy describes how preempted spot instances of a component are handled.
+type PreemptionPolicy struct {
+	// Whether preempted instances are replaced by new instances.
+	Replace bool
+	// Whether replacements are created as on-demand instances rather than spot instances.
+	OnDemand bool
+	// The zones in which replacements are preferably created, in order of preference. The zone
+	// of the preempted instance as well as zones which do not provide the requested GPUs or
+	// which are not allowed for the component are skipped. If no zone is suitable, the
+	// replacement is created in the zone of the preempted instance.
+	Zones []string
+}
+
+// PreemptionWatcher watches the providers of a Genesis service for preemptions of spot instances.
+// Preempted instances are marked as deleted and a deletion event with `REASON_PREEMPTED` is
+// published. If the preempted instance's component has a preemption policy which requests
+// replacements, a replacement instance is requested subsequently. The preemption watcher
+// implements the `mercury.Runnable` interface.
+type PreemptionWatcher struct {
+	service *Service
+}
+
+// NewPreemptionWatcher creates a new preemption watcher for the given service.
+func NewPreemptionWatcher(service *Service) *PreemptionWatcher {
+	return &PreemptionWatcher{service: service}
+}
+
+// Run watches all providers for preemptions until the context is cancelled or watching any
+// provider fails.
+func (w *PreemptionWatcher) Run(ctx context.Context) error {
+	clouds := jack.MapKeys(w.service.providers)
+	sort.Slice(clouds, func(i, j int) bool { return clouds[i] < clouds[j] })
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, cloud := range clouds {
+		p := w.service.providers[cloud]
+		eg.Go(func() error {
+			if err := p.WatchPreemptions(ctx, func(preemption provider.Preemption) {
+				w.service.handlePreemption(ctx, preemption)
+			}); err != nil && ctx.Err() == nil {
+				return fmt.Errorf("failed to watch preemptions of %s: %s", p.CloudProvider(), err)
+			}
+			return ctx.Err()
+		})
+	}
+	return eg.Wait()
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func (s *Service) handlePreemption(ctx context.Context, preemption provider.Preemption) {
+	logger := zeus.Logger(ctx).With(zap.Stringer("id", preemption.ID))
+	instance, err := s.store.Get(ctx, preemption.ID)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			logger.Error("failed to get preempted instance", zap.Error(err))
+		}
+		return
+	}
+	if instance.Status != store.StatusRunning {
+		// The preemption has already been handled
+		return
+	}
+	logger.Info("instance is preempted", zap.Time("time", preemption.Time))
+
+	// The replacement is requested after the preempted instance has been deleted such that the
+	// preempted instance does not count towards quotas anymore. As the ID of the replacement is
+	// deterministic, it can already be referenced in the deletion event.
+	deleted := &genesis_messages.InstanceDeletedEvent{
+		Reason: genesis_messages.InstanceDeletedEvent_REASON_PREEMPTED,
+	}
+	policy, replace := s.preemptionPolicies[instance.Component]
+	replace = replace && policy.Replace
+	if replace {
+		deleted.Replacement = &genesis.Instance{Id: replacementID(instance.ID).String()}
+	}
+	if err := s.terminate(ctx, instance.ID, deleted); err != nil {
+		logger.Error("failed to terminate preempted instance", zap.Error(err))
+		return
+	}
+	if replace {
+		s.replace(ctx, instance, policy)
+	}
+}
+
+// replace requests a new instance which replaces the given instance according to the provided
+// policy. As replacements are requested asynchronously, failures are announced via events. If the
+// replacement was already requested, e.g. due to a duplicate report of the preemption, this is a
+// noop.
+func (s *Service) replace(ctx context.Context, instance store.Instance, policy PreemptionPolicy) {
+	req := proto.Clone(instance.Request).(*genesis.CreateInstanceRequest)
+	req.Id = replacementID(instance.ID).String()
+	req.Config.IsSpot = req.Config.IsSpot && !policy.OnDemand
+	for _, zone := range policy.Zones {
+		if zone == instance.Config.Zone {
+			continue
+		}
+		config := proto.Clone(req.Config).(*genesis.InstanceConfig)
+		config.Zone = zone
+		if s.validateZone(ctx, config, req.Resources) == nil &&
+			s.validateComponent(req.Component, config, req.Resources) == nil {
+			req.Config = config
+			break
+		}
+	}
+
+	logger := zeus.Logger(ctx).With(
+		zap.Stringer("id", instance.ID), zap.String("replacement", req.Id),
+	)
+	if _, err := s.CreateInstance(ctx, req); err != nil {
+		if code := status.Code(err); code == codes.AlreadyExists ||
+			code == codes.FailedPrecondition {
+			// A previous report of the same preemption already requested the replacement,
+			// possibly in a different zone. If it failed, the failure was already announced.
+			logger.Debug("replacement of preempted instance was already requested")
+			return
+		}
+		logger.Error("failed to replace preempted instance", zap.Error(err))
+		s.publish(ctx, req.Owner, instanceCreationFailedEvent(uuid.MustParse(req.Id), err))
+		return
+	}
+	logger.Info("requested replacement of preempted instance")
+}
+
+// replacementID returns the ID of the instance replacing the instance with the given ID.
+func replacementID(id uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(id, []byte("replacement"))
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	"go.taskfleet.io/packages/dymant/memory"
+	"go.taskfleet.io/services/genesis/quota"
+)
+
+func TestPreemption(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue))
+	f.runPreemptionWatcher()
+
+	req := f.createRequest("owner")
+	req.Config.IsSpot = true
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+	awaitEvent(t, queue)
+
+	require.True(t, f.provider.Preempt(uuid.MustParse(req.Id)))
+	f.awaitRunning("owner", 0)
+
+	event := awaitEvent(t, queue)
+	assert.Equal(t, req.Id, event.Instance.Id)
+	assert.Equal(t,
+		genesis_messages.InstanceDeletedEvent_REASON_PREEMPTED, event.GetDeleted().GetReason(),
+	)
+	assert.Nil(t, event.GetDeleted().GetReplacement())
+}
+
+func TestPreemptionReplacement(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue), WithPreemptionPolicy("worker",
+		PreemptionPolicy{Replace: true, OnDemand: true, Zones: []string{"us-east1-c"}},
+	))
+	f.runPreemptionWatcher()
+
+	req := f.createRequest("owner")
+	req.Config.IsSpot = true
+	req.Resources.Gpu = nil
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+	awaitEvent(t, queue)
+
+	// The deletion event must reference the replacement
+	require.True(t, f.provider.Preempt(uuid.MustParse(req.Id)))
+	var events []*genesis_messages.InstanceEvent
+	require.Eventually(t, func() bool {
+		for _, message := range queue.GetMessages() {
+			events = append(events, message.(*genesis_messages.InstanceEvent))
+		}
+		return len(events) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	var deleted *genesis_messages.InstanceDeletedEvent
+	for _, event := range events {
+		if event.Instance.Id == req.Id {
+			deleted = event.GetDeleted()
+		}
+	}
+	require.NotNil(t, deleted)
+	assert.Equal(t, genesis_messages.InstanceDeletedEvent_REASON_PREEMPTED, deleted.Reason)
+	require.NotNil(t, deleted.Replacement)
+
+	// The replacement must be an on-demand instance in the preferred zone
+	running := f.awaitRunning("owner", 1)
+	assert.Equal(t, deleted.Replacement.Id, running[0].Instance.Id)
+	assert.Equal(t, "us-east1-c", running[0].Config.Zone)
+	assert.False(t, running[0].Config.IsSpot)
+}
+
+func TestPreemptionReplacementQuota(t *testing.T) {
+	maxInstances := uint32(1)
+	enforcer, err := quota.NewEnforcer(quota.Config{
+		Owners: map[string]quota.Limits{"owner": {MaxInstances: &maxInstances}},
+	})
+	require.Nil(t, err)
+
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t,
+		WithPublisher(queue),
+		WithQuotas(enforcer),
+		WithPreemptionPolicy("worker", PreemptionPolicy{Replace: true}),
+	)
+	f.runPreemptionWatcher()
+
+	req := f.createRequest("owner")
+	req.Config.IsSpot = true
+	_, err = f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+	awaitEvent(t, queue)
+
+	// The preempted instance must not count towards the quota of the replacement
+	require.True(t, f.provider.Preempt(uuid.MustParse(req.Id)))
+	var events []*genesis_messages.InstanceEvent
+	require.Eventually(t, func() bool {
+		for _, message := range queue.GetMessages() {
+			events = append(events, message.(*genesis_messages.InstanceEvent))
+		}
+		return len(events) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	deleted := events[0].GetDeleted()
+	require.NotNil(t, deleted.GetReplacement())
+	assert.Equal(t, deleted.Replacement.Id, events[1].Instance.Id)
+	assert.NotNil(t, events[1].GetCreated())
+}
+
+func TestPreemptionReplacementDuplicate(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue), WithPreemptionPolicy("worker",
+		PreemptionPolicy{Replace: true, Zones: []string{"us-east1-c"}},
+	))
+	f.runPreemptionWatcher()
+
+	req := f.createRequest("owner")
+	req.Config.IsSpot = true
+	req.Resources.Gpu = nil
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+	awaitEvent(t, queue)
+
+	require.True(t, f.provider.Preempt(uuid.MustParse(req.Id)))
+	count := 0
+	require.Eventually(t, func() bool {
+		count += len(queue.GetMessages())
+		return count == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// A duplicate report whose replacement request differs must not announce a failure
+	instance, err := f.service.store.Get(f.ctx, uuid.MustParse(req.Id))
+	require.Nil(t, err)
+	f.service.replace(f.ctx, instance, PreemptionPolicy{Replace: true})
+	assert.Empty(t, queue.GetMessages())
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func (f *serviceFixture) runPreemptionWatcher() {
+	ctx, cancel := context.WithCancel(f.ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		NewPreemptionWatcher(f.service).Run(ctx) // nolint:errcheck
+	}()
+	f.t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	require.Eventually(f.t, f.provider.Watching, time.Second, time.Millisecond)
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/quota"
+	"go.taskfleet.io/services/genesis/store"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// register adds the given pending instances to the store. Either all or none of the instances
+// are added. If quotas are configured, the instances are only added if they jointly do not exceed
+// the quotas of their owner and component. Returned errors are gRPC errors unless they wrap
+// `store.ErrAlreadyExists`, which indicates that the first instance was registered before.
+func (s *Service) register(ctx context.Context, instances ...store.Instance) error {
+	if s.quotas != nil {
+		// Checking quotas and adding the instances must be atomic to prevent concurrent requests
+		// from jointly exceeding quotas
+		s.quotaMutex.Lock()
+		defer s.quotaMutex.Unlock()
+
+		if err := s.checkQuotas(ctx, instances); err != nil {
+			if errors.Is(err, quota.ErrExceeded) {
+				return status.Errorf(codes.ResourceExhausted, "%s", err)
+			}
+			return storeError(err)
+		}
+	}
+
+	for i, instance := range instances {
+		if err := s.store.Create(ctx, instance); err != nil {
+			for _, registered := range instances[:i] {
+				if err := s.store.Delete(ctx, registered.ID); err != nil {
+					zeus.Logger(ctx).Error("failed to remove partially registered instance",
+						zap.Stringer("id", registered.ID), zap.Error(err),
+					)
+				}
+			}
+			if errors.Is(err, store.ErrAlreadyExists) && i == 0 {
+				return err
+			}
+			return storeError(err)
+		}
+	}
+	return nil
+}
+
+// checkQuotas verifies that the given instances can be registered without exceeding quotas. The
+// returned error wraps `quota.ErrExceeded` if a quota would be exceeded.
+func (s *Service) checkQuotas(ctx context.Context, instances []store.Instance) error {
+	active, err := s.store.List(ctx, store.Filter{
+		Statuses: []store.Status{store.StatusPending, store.StatusRunning},
+	})
+	if err != nil {
+		return err
+	}
+	for _, other := range active {
+		if other.ID == instances[0].ID {
+			// The instances have been registered by a concurrent request, adding them fails
+			return nil
+		}
+	}
+
+	usage := jack.SliceMap(active, quotaInstance)
+	for _, instance := range instances {
+		if err := s.quotas.Check(quotaInstance(instance), usage); err != nil {
+			return err
+		}
+		usage = append(usage, quotaInstance(instance))
+	}
+	return nil
+}
+
+func quotaInstance(instance store.Instance) quota.Instance {
+	return quota.Instance{
+		Owner:     instance.Owner,
+		Component: instance.Component,
+		Resources: instance.Resources,
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	"go.taskfleet.io/services/genesis/store"
+	"go.uber.org/zap"
+)
+
+// Reaper periodically shuts down running instances which exceeded the maximum lifetime or the
+// idle timeout passed when requesting them. For reaped instances, a deletion event with
+// `REASON_EXPIRED` is published. The reaper implements the `mercury.Runnable` interface.
+//
+// Instances are idle while their heartbeats report a low utilization (see `InstanceHeartbeat`).
+// As heartbeats are not persisted, all instances are granted their full idle timeout after the
+// service restarted.
+type Reaper struct {
+	service  *Service
+	interval time.Duration
+}
+
+// NewReaper creates a new reaper for the given service which checks for expired instances at the
+// provided interval. The interval determines the precision with which lifetimes and idle timeouts
+// are enforced.
+func NewReaper(service *Service, interval time.Duration) *Reaper {
+	return &Reaper{service: service, interval: interval}
+}
+
+// Run reaps expired instances at the configured interval until the context is cancelled. Failures
+// of individual runs are logged but do not cause the reaper to exit.
+func (r *Reaper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.Reap(ctx); err != nil {
+				zeus.Logger(ctx).Error("failed to reap expired instances", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Reap shuts down all running instances which are expired at the moment.
+func (r *Reaper) Reap(ctx context.Context) error {
+	instances, err := r.service.store.List(ctx, store.Filter{
+		Statuses: []store.Status{store.StatusRunning},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list running instances: %s", err)
+	}
+
+	now := time.Now()
+	for _, instance := range instances {
+		reason := r.expiry(instance, now)
+		if reason == "" {
+			continue
+		}
+
+		logger := zeus.Logger(ctx).With(zap.Stringer("id", instance.ID))
+		logger.Info("shutting down expired instance", zap.String("reason", reason))
+		deleted := &genesis_messages.InstanceDeletedEvent{
+			Reason: genesis_messages.InstanceDeletedEvent_REASON_EXPIRED,
+		}
+		if err := r.service.terminate(ctx, instance.ID, deleted); err != nil {
+			logger.Error("failed to shut down expired instance", zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// expiry returns why the given instance is expired at the provided time or an empty string if it
+// is not expired.
+func (r *Reaper) expiry(instance store.Instance, now time.Time) string {
+	if lifetime := instance.Request.GetMaxLifetime(); lifetime != nil {
+		if now.Sub(instance.CreatedAt) > lifetime.AsDuration() {
+			return "maximum lifetime exceeded"
+		}
+	}
+	if timeout := instance.Request.GetIdleTimeout(); timeout != nil {
+		idleSince := instance.CreatedAt
+		for _, t := range []time.Time{
+			r.service.startedAt, r.service.heartbeats.activeAt(instance.ID),
+		} {
+			if t.After(idleSince) {
+				idleSince = t
+			}
+		}
+		if now.Sub(idleSince) > timeout.AsDuration() {
+			return "idle timeout exceeded"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant/memory"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestReaperMaxLifetime(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue))
+
+	expiring := f.createRequest("owner")
+	expiring.MaxLifetime = durationpb.New(200 * time.Millisecond)
+	unlimited := f.createRequest("owner")
+	for _, req := range []*genesis.CreateInstanceRequest{expiring, unlimited} {
+		_, err := f.client.CreateInstance(f.ctx, req)
+		require.Nil(t, err)
+	}
+	f.awaitRunning("owner", 2)
+	queue.GetMessages()
+
+	// The instance must not be reaped before its lifetime is exceeded
+	reaper := NewReaper(f.service, time.Second)
+	require.Nil(t, reaper.Reap(f.ctx))
+	f.awaitRunning("owner", 2)
+
+	time.Sleep(200 * time.Millisecond)
+	require.Nil(t, reaper.Reap(f.ctx))
+	running := f.awaitRunning("owner", 1)
+	assert.Equal(t, unlimited.Id, running[0].Instance.Id)
+
+	event := awaitEvent(t, queue)
+	assert.Equal(t, expiring.Id, event.Instance.Id)
+	assert.Equal(t,
+		genesis_messages.InstanceDeletedEvent_REASON_EXPIRED, event.GetDeleted().GetReason(),
+	)
+}
+
+func TestReaperIdleTimeout(t *testing.T) {
+	f := newServiceFixture(t)
+
+	idle := f.createRequest("owner")
+	active := f.createRequest("owner")
+	for _, req := range []*genesis.CreateInstanceRequest{idle, active} {
+		req.IdleTimeout = durationpb.New(200 * time.Millisecond)
+		_, err := f.client.CreateInstance(f.ctx, req)
+		require.Nil(t, err)
+	}
+	f.awaitRunning("owner", 2)
+
+	// Only heartbeats reporting a high utilization must reset the idle timeout
+	time.Sleep(150 * time.Millisecond)
+	heartbeats := map[string]*genesis.InstanceUtilization{
+		idle.Id:   {Cpu: 0.01, Memory: 0.8},
+		active.Id: {Cpu: 0.01, Gpu: 0.9},
+	}
+	for id, utilization := range heartbeats {
+		_, err := f.client.InstanceHeartbeat(f.ctx, &genesis.InstanceHeartbeatRequest{
+			Instance:    &genesis.Instance{Id: id},
+			Utilization: utilization,
+		})
+		require.Nil(t, err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	require.Nil(t, NewReaper(f.service, time.Second).Reap(f.ctx))
+	running := f.awaitRunning("owner", 1)
+	assert.Equal(t, active.Id, running[0].Instance.Id)
+}
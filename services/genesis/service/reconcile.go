@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/store"
+	"go.uber.org/zap"
+)
+
+// Reconciler periodically compares the instances that actually exist at the cloud providers with
+// the instances tracked by a Genesis service and resolves any drift:
+//
+//   - Running instances which do not exist anymore are marked as deleted and a deletion event with
+//     `REASON_TERMINATED` is published. Spot instances are assumed to have been preempted instead
+//     and are handled just like preemptions reported to the `PreemptionWatcher`.
+//   - Instances which are managed by Taskfleet (i.e. carry the `provider.TagOwner` tag) but are not
+//     tracked by the service or failed to be created are deleted. Such instances are typically
+//     leaked when the creation of an instance times out.
+//
+// The reconciler implements the `mercury.Runnable` interface.
+type Reconciler struct {
+	service  *Service
+	interval time.Duration
+}
+
+// NewReconciler creates a new reconciler for the given service which reconciles the state of the
+// service with the state of its providers at the provided interval.
+func NewReconciler(service *Service, interval time.Duration) *Reconciler {
+	return &Reconciler{service: service, interval: interval}
+}
+
+// Run runs reconciliation at the configured interval until the context is cancelled. Failures of
+// individual reconciliation runs are logged but do not cause the reconciler to exit.
+func (r *Reconciler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.Reconcile(ctx); err != nil {
+				zeus.Logger(ctx).Error("failed to reconcile instances", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Reconcile runs a single reconciliation. If the instances of a provider cannot be listed, the
+// instances of all other providers are still reconciled.
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	// Instances are listed from the store before listing them from the providers. Thus, running
+	// instances that are missing at a provider have certainly been terminated. Instances that are
+	// unknown to the store, however, need to be checked again before deleting them as they might
+	// have been created in the meantime.
+	instances, err := r.service.store.List(ctx, store.Filter{})
+	if err != nil {
+		return fmt.Errorf("failed to list instances from store: %s", err)
+	}
+	tracked := map[uuid.UUID]store.Instance{}
+	for _, instance := range instances {
+		tracked[instance.ID] = instance
+	}
+
+	var errs []error
+	for cloud, p := range r.service.providers {
+		if err := r.reconcileProvider(ctx, p, tracked); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", cloud, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to reconcile providers: %v", errs)
+	}
+	return nil
+}
+
+func (r *Reconciler) reconcileProvider(
+	ctx context.Context, p provider.Provider, tracked map[uuid.UUID]store.Instance,
+) error {
+	actual, err := p.ListInstances(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list instances: %s", err)
+	}
+	existing := map[uuid.UUID]struct{}{}
+	for _, instance := range actual {
+		existing[instance.ID] = struct{}{}
+	}
+
+	// Delete all orphaned instances
+	for _, instance := range actual {
+		if !instance.IsManaged() {
+			continue
+		}
+		if record, ok := tracked[instance.ID]; ok && isActive(record) {
+			continue
+		}
+		if err := r.deleteOrphan(ctx, p, instance); err != nil {
+			return err
+		}
+	}
+
+	// Mark all running instances which do not exist anymore as terminated
+	for _, record := range tracked {
+		if record.Config.CloudProvider != p.CloudProvider() ||
+			record.Status != store.StatusRunning {
+			continue
+		}
+		if _, ok := existing[record.ID]; ok {
+			continue
+		}
+		if err := r.markTerminated(ctx, record.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) deleteOrphan(
+	ctx context.Context, p provider.Provider, instance provider.Instance,
+) error {
+	record, err := r.service.store.Get(ctx, instance.ID)
+	switch {
+	case err == nil && isActive(record):
+		return nil
+	case err != nil && !errors.Is(err, store.ErrNotFound):
+		return fmt.Errorf("failed to get instance %s: %s", instance.ID, err)
+	}
+
+	logger := zeus.Logger(ctx).With(zap.Stringer("id", instance.ID))
+	err = p.DeleteInstance(ctx, instance.Zone, instance.ID)
+	if err != nil && !errors.Is(err, provider.ErrNotFound) {
+		logger.Error("failed to delete orphaned instance", zap.Error(err))
+		return nil
+	}
+	logger.Info("deleted orphaned instance", zap.String("zone", instance.Zone))
+	return nil
+}
+
+func (r *Reconciler) markTerminated(ctx context.Context, id uuid.UUID) error {
+	// The instance might have been shut down while reconciling, so we need to check its status
+	// again prior to marking it as terminated.
+	instance, err := r.service.store.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get instance %s: %s", id, err)
+	}
+	if instance.Status != store.StatusRunning {
+		return nil
+	}
+
+	// Spot instances usually vanish because they were preempted. If the preemption was not (yet)
+	// reported by the provider, the preemption policy must still apply.
+	if instance.Config.IsSpot {
+		r.service.handlePreemption(ctx, provider.Preemption{
+			ID: id, Zone: instance.Config.Zone, Time: time.Now(),
+		})
+		return nil
+	}
+
+	zeus.Logger(ctx).Info("instance was terminated by provider", zap.Stringer("id", id))
+	deleted := &genesis_messages.InstanceDeletedEvent{
+		Reason: genesis_messages.InstanceDeletedEvent_REASON_TERMINATED,
+	}
+	if err := r.service.markDeleted(ctx, instance, deleted); err != nil {
+		return fmt.Errorf("failed to mark instance %s as terminated: %s", id, err)
+	}
+	return nil
+}
+
+// isActive returns whether the given instance is expected to exist at its provider.
+func isActive(instance store.Instance) bool {
+	return instance.Status == store.StatusPending || instance.Status == store.StatusRunning
+}
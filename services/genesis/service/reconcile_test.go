@@ -0,0 +1,133 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	"go.taskfleet.io/packages/dymant/memory"
+	"go.taskfleet.io/services/genesis/provider"
+)
+
+func TestReconcileTerminated(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue))
+	reconciler := NewReconciler(f.service, time.Hour)
+
+	req := f.createRequest("owner")
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+	awaitEvent(t, queue)
+
+	// Reconciliation must not change anything as long as the instance exists
+	require.Nil(t, reconciler.Reconcile(f.ctx))
+	f.awaitRunning("owner", 1)
+
+	// Once the provider terminated the instance, it must be removed
+	require.True(t, f.provider.Terminate(uuid.MustParse(req.Id)))
+	require.Nil(t, reconciler.Reconcile(f.ctx))
+	f.awaitRunning("owner", 0)
+
+	event := awaitEvent(t, queue)
+	assert.Equal(t, req.Id, event.Instance.Id)
+	assert.Equal(t,
+		genesis_messages.InstanceDeletedEvent_REASON_TERMINATED, event.GetDeleted().GetReason(),
+	)
+}
+
+func TestReconcilePreempted(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue), WithPreemptionPolicy("worker",
+		PreemptionPolicy{Replace: true},
+	))
+	reconciler := NewReconciler(f.service, time.Hour)
+
+	req := f.createRequest("owner")
+	req.Config.IsSpot = true
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+	awaitEvent(t, queue)
+
+	// A spot instance which vanished without a reported preemption must still be replaced
+	require.True(t, f.provider.Terminate(uuid.MustParse(req.Id)))
+	require.Nil(t, reconciler.Reconcile(f.ctx))
+
+	var events []*genesis_messages.InstanceEvent
+	require.Eventually(t, func() bool {
+		for _, message := range queue.GetMessages() {
+			events = append(events, message.(*genesis_messages.InstanceEvent))
+		}
+		return len(events) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, req.Id, events[0].Instance.Id)
+	deleted := events[0].GetDeleted()
+	assert.Equal(t, genesis_messages.InstanceDeletedEvent_REASON_PREEMPTED, deleted.GetReason())
+	require.NotNil(t, deleted.GetReplacement())
+	assert.Equal(t, deleted.Replacement.Id, events[1].Instance.Id)
+	assert.NotNil(t, events[1].GetCreated())
+}
+
+func TestReconcileOrphans(t *testing.T) {
+	f := newServiceFixture(t)
+	reconciler := NewReconciler(f.service, time.Hour)
+
+	req := f.createRequest("owner")
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+
+	orphan := provider.Instance{
+		ID:   uuid.New(),
+		Zone: "europe-west1-b",
+		Tags: map[string]string{provider.TagOwner: "owner"},
+	}
+	f.provider.AddInstance(orphan)
+	unmanaged := provider.Instance{ID: uuid.New(), Zone: "europe-west1-b"}
+	f.provider.AddInstance(unmanaged)
+
+	// Only the orphaned instance must be deleted
+	require.Nil(t, reconciler.Reconcile(f.ctx))
+	_, ok := f.provider.Instance(orphan.ID)
+	assert.False(t, ok)
+	_, ok = f.provider.Instance(unmanaged.ID)
+	assert.True(t, ok)
+	_, ok = f.provider.Instance(uuid.MustParse(req.Id))
+	assert.True(t, ok)
+}
+
+func TestReconcileConcurrentShutdown(t *testing.T) {
+	queue := memory.NewQueue(10)
+	f := newServiceFixture(t, WithPublisher(queue))
+	reconciler := NewReconciler(f.service, time.Hour)
+
+	req := f.createRequest("owner")
+	_, err := f.client.CreateInstance(f.ctx, req)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+	awaitEvent(t, queue)
+
+	// Reconciliation runs after a shutdown deleted the instance at its provider but before the
+	// shutdown marked it as deleted
+	id := uuid.MustParse(req.Id)
+	instance, err := f.service.store.Get(f.ctx, id)
+	require.Nil(t, err)
+	require.True(t, f.provider.Terminate(id))
+	require.Nil(t, reconciler.Reconcile(f.ctx))
+	shutdown := &genesis_messages.InstanceDeletedEvent{
+		Reason: genesis_messages.InstanceDeletedEvent_REASON_SHUTDOWN,
+	}
+	require.Nil(t, f.service.markDeleted(f.ctx, instance, shutdown))
+
+	// Only the first transition must publish an event
+	event := awaitEvent(t, queue)
+	assert.Equal(t,
+		genesis_messages.InstanceDeletedEvent_REASON_TERMINATED, event.GetDeleted().GetReason(),
+	)
+	assert.Empty(t, queue.GetMessages())
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/dymant"
+	"go.taskfleet.io/packages/mercury"
+	"go.taskfleet.io/services/genesis/catalog"
+	"go.taskfleet.io/services/genesis/component"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/quota"
+	"go.taskfleet.io/services/genesis/store"
+	"go.taskfleet.io/services/genesis/store/memory"
+	"google.golang.org/grpc/status"
+)
+
+// Service is the reference implementation of the Genesis gRPC service. It delegates the actual
+// management of compute instances to a set of providers, one per cloud provider, and keeps track
+// of the instances it created in a store. Lifecycle events of instances are published as
+// `genesis_messages.InstanceEvent` if a publisher is configured. Similarly, lifecycle events of
+// instance groups are published as `genesis_messages.InstanceGroupEvent`.
+//
+// Instances are created asynchronously: the service must be run (see `Run`) in order to process
+// instance creations. When the service is restarted with a persistent store, instance creations
+// which were pending when the service was stopped are recovered.
+type Service struct {
+	genesis.UnimplementedGenesisServiceServer
+
+	providers          map[genesis.CloudProvider]provider.Provider
+	catalog            *catalog.Catalog
+	components         *component.Registry
+	zones              *catalog.ZoneCatalog
+	zoneCacheTTL       time.Duration
+	store              store.Store
+	publisher          dymant.Publisher
+	groupPublisher     dymant.Publisher
+	creationTimeout    time.Duration
+	preemptionPolicies map[string]PreemptionPolicy
+	quotas             *quota.Enforcer
+	quotaMutex         sync.Mutex
+	jobs               chan func(context.Context)
+	recovered          chan struct{}
+	recoverOnce        sync.Once
+	watchers           *watcherSet
+	heartbeats         *heartbeatSet
+	startedAt          time.Time
+}
+
+// NewService creates a new Genesis service which manages instances via the given providers. At
+// least one provider must be passed and no two providers may manage the same cloud provider.
+func NewService(providers []provider.Provider, options ...Option) (*Service, error) {
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("at least one provider must be provided")
+	}
+
+	s := &Service{
+		providers:          map[genesis.CloudProvider]provider.Provider{},
+		store:              memory.NewStore(),
+		zoneCacheTTL:       5 * time.Minute,
+		creationTimeout:    10 * time.Minute,
+		preemptionPolicies: map[string]PreemptionPolicy{},
+		jobs:               make(chan func(context.Context), 64),
+		recovered:          make(chan struct{}),
+		watchers:           newWatcherSet(),
+		heartbeats:         newHeartbeatSet(),
+		startedAt:          time.Now(),
+	}
+	for _, p := range providers {
+		if _, ok := s.providers[p.CloudProvider()]; ok {
+			return nil, fmt.Errorf("found multiple providers for %s", p.CloudProvider())
+		}
+		s.providers[p.CloudProvider()] = p
+	}
+	for _, option := range options {
+		option.apply(s)
+	}
+	s.zones = catalog.NewZoneCatalog(providers, s.catalog, s.zoneCacheTTL)
+	return s, nil
+}
+
+// Register registers the service with the provided gRPC server. The gRPC server should be
+// configured with `mercury.WithRequestValidation` as the service expects all requests to be valid.
+func (s *Service) Register(server *mercury.Grpc) {
+	genesis.RegisterGenesisServiceServer(server.Server, s)
+}
+
+// Run processes instance creations until the context is cancelled. Prior to processing new
+// instance creations, it recovers all instances which were pending when the service was last
+// stopped. Creation requests are only accepted once this recovery has finished such that no
+// instance is created twice. Upon cancellation, it waits for all instance creations that are
+// currently in progress to be aborted.
+func (s *Service) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	run := func(job func(context.Context)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			job(ctx)
+		}()
+	}
+
+	jobs, err := s.recoverPending(ctx)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		run(job)
+	}
+	s.recoverOnce.Do(func() { close(s.recovered) })
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case job := <-s.jobs:
+			run(job)
+		}
+	}
+}
+
+// awaitRecovery blocks until `Run` has recovered all pending instances. Creations must not be
+// registered before, otherwise the recovery would resume creations which are already scheduled.
+func (s *Service) awaitRecovery(ctx context.Context) error {
+	select {
+	case <-s.recovered:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
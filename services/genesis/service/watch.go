@@ -0,0 +1,111 @@
+package service
+
+import (
+	"sync"
+
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// watchBufferSize is the number of events that may be queued for a single watcher. If a watcher
+// does not consume events quickly enough, its stream is terminated.
+const watchBufferSize = 256
+
+// WatchInstances implements the genesis.GenesisServiceServer interface.
+func (s *Service) WatchInstances(
+	req *genesis.WatchInstancesRequest, stream genesis.GenesisService_WatchInstancesServer,
+) error {
+	// We need to start watching prior to listing running instances to not miss any events
+	w := s.watchers.add(req.Owner)
+	defer s.watchers.remove(w)
+
+	running, err := s.runningInstances(stream.Context(), req.Owner)
+	if err != nil {
+		return err
+	}
+	for _, instance := range running {
+		if err := stream.Send(&genesis.WatchInstancesResponse{
+			Update: &genesis.WatchInstancesResponse_Running{Running: instance},
+		}); err != nil {
+			return err
+		}
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-w.overflow:
+			return status.Errorf(codes.ResourceExhausted, "watcher did not keep up with events")
+		case event := <-w.events:
+			wrapped, err := anypb.New(event)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to wrap event: %s", err)
+			}
+			if err := stream.Send(&genesis.WatchInstancesResponse{
+				Update: &genesis.WatchInstancesResponse_Event{Event: wrapped},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+//-------------------------------------------------------------------------------------------------
+// WATCHERS
+//-------------------------------------------------------------------------------------------------
+
+type watcher struct {
+	owner    string
+	events   chan *genesis_messages.InstanceEvent
+	overflow chan struct{}
+}
+
+type watcherSet struct {
+	mutex    sync.Mutex
+	watchers map[*watcher]struct{}
+}
+
+func newWatcherSet() *watcherSet {
+	return &watcherSet{watchers: map[*watcher]struct{}{}}
+}
+
+func (s *watcherSet) add(owner string) *watcher {
+	w := &watcher{
+		owner:    owner,
+		events:   make(chan *genesis_messages.InstanceEvent, watchBufferSize),
+		overflow: make(chan struct{}),
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.watchers[w] = struct{}{}
+	return w
+}
+
+func (s *watcherSet) remove(w *watcher) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.watchers, w)
+}
+
+// notify delivers the event to all watchers of the given owner. Watchers whose buffer is full are
+// removed and notified about the overflow.
+func (s *watcherSet) notify(owner string, event *genesis_messages.InstanceEvent) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for w := range s.watchers {
+		if w.owner != owner {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			close(w.overflow)
+			delete(s.watchers, w)
+		}
+	}
+}
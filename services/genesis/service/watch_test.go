@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWatchInstances(t *testing.T) {
+	f := newServiceFixture(t)
+
+	// Create an instance which is running when the watch starts
+	running := f.createRequest("owner")
+	_, err := f.client.CreateInstance(f.ctx, running)
+	require.Nil(t, err)
+	f.awaitRunning("owner", 1)
+
+	ctx, cancel := context.WithCancel(f.ctx)
+	defer cancel()
+	stream, err := f.client.WatchInstances(ctx, &genesis.WatchInstancesRequest{Owner: "owner"})
+	require.Nil(t, err)
+
+	response, err := stream.Recv()
+	require.Nil(t, err)
+	assert.Equal(t, running.Id, response.GetRunning().GetInstance().GetId())
+
+	// Instances of other owners must not be delivered
+	_, err = f.client.CreateInstance(f.ctx, f.createRequest("other"))
+	require.Nil(t, err)
+	f.awaitRunning("other", 1)
+
+	// Create and shut down another instance
+	created := f.createRequest("owner")
+	_, err = f.client.CreateInstance(f.ctx, created)
+	require.Nil(t, err)
+
+	event := recvEvent(t, stream)
+	assert.Equal(t, created.Id, event.Instance.Id)
+	assert.NotNil(t, event.GetCreated())
+
+	_, err = f.client.ShutdownInstance(f.ctx, &genesis.ShutdownInstanceRequest{
+		Instance: &genesis.Instance{Id: running.Id},
+	})
+	require.Nil(t, err)
+
+	event = recvEvent(t, stream)
+	assert.Equal(t, running.Id, event.Instance.Id)
+	assert.NotNil(t, event.GetDeleted())
+
+	// Cancelling the watch terminates the stream
+	cancel()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestWatchInstancesOverflow(t *testing.T) {
+	set := newWatcherSet()
+	w := set.add("owner")
+	for i := 0; i < watchBufferSize+1; i++ {
+		set.notify("owner", &genesis_messages.InstanceEvent{})
+	}
+	select {
+	case <-w.overflow:
+	default:
+		t.Error("expected watcher to overflow")
+	}
+	assert.Len(t, set.watchers, 0)
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func recvEvent(
+	t *testing.T, stream genesis.GenesisService_WatchInstancesClient,
+) *genesis_messages.InstanceEvent {
+	response, err := stream.Recv()
+	require.Nil(t, err)
+	require.NotNil(t, response.GetEvent())
+
+	event := &genesis_messages.InstanceEvent{}
+	require.Nil(t, response.GetEvent().UnmarshalTo(event))
+	return event
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/catalog"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListZones implements the genesis.GenesisServiceServer interface.
+func (s *Service) ListZones(
+	ctx context.Context, req *genesis.ListZonesRequest,
+) (*genesis.ListZonesResponse, error) {
+	zones, err := s.zones.Zones(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to list zones: %s", err)
+	}
+	return &genesis.ListZonesResponse{
+		Zones: jack.SliceMap(zones, func(zone catalog.Zone) *genesis.Zone {
+			return &genesis.Zone{
+				Provider:      zone.CloudProvider,
+				Name:          zone.Name,
+				AvailableGpus: zone.GPUs,
+			}
+		}),
+	}, nil
+}
+
+//-------------------------------------------------------------------------------------------------
+
+// validateZone ensures that the zone referenced by the given configuration exists and provides
+// the requested GPU kind (if any).
+func (s *Service) validateZone(
+	ctx context.Context, config *genesis.InstanceConfig, resources *genesis.InstanceResources,
+) error {
+	if _, ok := s.providers[config.CloudProvider]; !ok {
+		return status.Errorf(
+			codes.InvalidArgument, "cloud provider %s is not enabled", config.CloudProvider,
+		)
+	}
+	zone, ok, err := s.zones.Zone(ctx, config.CloudProvider, config.Zone)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to list zones: %s", err)
+	}
+	if !ok {
+		return status.Errorf(codes.InvalidArgument,
+			"zone %q does not exist for %s", config.Zone, config.CloudProvider,
+		)
+	}
+	if gpu := resources.GetGpu(); gpu != nil && !slices.Contains(zone.GPUs, gpu.Kind) {
+		return status.Errorf(
+			codes.InvalidArgument, "GPU %s is not available in zone %q", gpu.Kind, config.Zone,
+		)
+	}
+	return nil
+}
+
+// matchInstanceType returns the machine type and actual resources of the instance to be created
+// for the given request. If no catalog is configured, the machine type is empty and the requested
+// resources are returned.
+func (s *Service) matchInstanceType(
+	req *genesis.CreateInstanceRequest,
+) (string, *genesis.InstanceResources, error) {
+	if s.catalog == nil {
+		return "", req.Resources, nil
+	}
+	instanceType, err := s.catalog.Match(catalog.Request{
+		CloudProvider: req.Config.CloudProvider,
+		Zone:          req.Config.Zone,
+		IsSpot:        req.Config.IsSpot,
+		Resources:     req.Resources,
+		PreferHPC:     req.PreferHpc,
+	})
+	if err != nil {
+		return "", nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return instanceType.Name, instanceType.Resources(), nil
+}
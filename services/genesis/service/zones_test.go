@@ -0,0 +1,22 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+)
+
+func TestListZones(t *testing.T) {
+	f := newServiceFixture(t)
+
+	response, err := f.client.ListZones(f.ctx, &genesis.ListZonesRequest{})
+	require.Nil(t, err)
+	require.Len(t, response.Zones, 2)
+
+	zone := response.Zones[0]
+	assert.Equal(t, genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM, zone.Provider)
+	assert.Equal(t, "europe-west1-b", zone.Name)
+	assert.Equal(t, []genesis.GPUKind{genesis.GPUKind_GPU_KIND_TESLA_T4}, zone.AvailableGpus)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/borchero/zeus/pkg/zeus"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/packages/mercury"
+	"go.taskfleet.io/services/genesis/provider"
+	"go.taskfleet.io/services/genesis/provider/fake"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type serviceFixture struct {
+	t        *testing.T
+	ctx      context.Context
+	provider *fake.Provider
+	service  *Service
+	client   genesis.GenesisServiceClient
+}
+
+var fixtureZones = []provider.Zone{
+	{Name: "europe-west1-b", GPUs: []genesis.GPUKind{genesis.GPUKind_GPU_KIND_TESLA_T4}},
+	{Name: "us-east1-c", GPUs: []genesis.GPUKind{}},
+}
+
+func newServiceFixture(t *testing.T, options ...Option) *serviceFixture {
+	ctx := zeus.WithNopLogger(context.Background())
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	t.Cleanup(cancel)
+
+	// Initialize service
+	fakeProvider := fake.NewProvider(genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		fixtureZones...,
+	)
+	service, err := NewService([]provider.Provider{fakeProvider}, options...)
+	require.Nil(t, err)
+
+	// Run server
+	server, err := mercury.NewGrpc(0, mercury.WithRequestValidation())
+	require.Nil(t, err)
+	service.Register(server)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Server.Serve(listener) // nolint:errcheck
+	t.Cleanup(server.Server.Stop)
+
+	serviceCtx, serviceCancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.Run(serviceCtx) // nolint:errcheck
+	}()
+	t.Cleanup(func() {
+		serviceCancel()
+		<-done
+	})
+
+	// Setup client
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		conn.Close() // nolint:errcheck
+	})
+
+	return &serviceFixture{
+		t:        t,
+		ctx:      ctx,
+		provider: fakeProvider,
+		service:  service,
+		client:   genesis.NewGenesisServiceClient(conn),
+	}
+}
+
+func (f *serviceFixture) createRequest(owner string) *genesis.CreateInstanceRequest {
+	return &genesis.CreateInstanceRequest{
+		Id:        uuid.NewString(),
+		Owner:     owner,
+		Component: "worker",
+		Config: &genesis.InstanceConfig{
+			CloudProvider: genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+			Zone:          "europe-west1-b",
+		},
+		Resources: &genesis.InstanceResources{
+			CpuCount: 4,
+			Memory:   16384,
+			Gpu: &genesis.GPUResources{
+				Kind:  genesis.GPUKind_GPU_KIND_TESLA_T4,
+				Count: 1,
+			},
+		},
+	}
+}
+
+func (f *serviceFixture) awaitRunning(owner string, count int) []*genesis.RunningInstance {
+	var instances []*genesis.RunningInstance
+	require.Eventually(f.t, func() bool {
+		response, err := f.client.ListInstances(f.ctx, &genesis.ListInstancesRequest{Owner: owner})
+		require.Nil(f.t, err)
+		instances = response.Instances
+		return len(instances) == count
+	}, time.Second, 10*time.Millisecond)
+	return instances
+}
+
+func (f *serviceFixture) instanceIDs(instances []*genesis.RunningInstance) []string {
+	return jack.SliceMap(instances, func(i *genesis.RunningInstance) string {
+		return i.Instance.Id
+	})
+}
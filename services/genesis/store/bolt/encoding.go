@@ -0,0 +1,98 @@
+package bolt
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// record is the representation of an instance in the database. Protobuf messages are stored in
+// their binary encoding to remain compatible with future changes of the messages.
+type record struct {
+	ID          uuid.UUID    `json:"id"`
+	Owner       string       `json:"owner"`
+	Component   string       `json:"component"`
+	GroupID     uuid.UUID    `json:"groupId"`
+	Request     []byte       `json:"request"`
+	Config      []byte       `json:"config"`
+	MachineType string       `json:"machineType"`
+	Resources   []byte       `json:"resources"`
+	Status      store.Status `json:"status"`
+	Hostname    string       `json:"hostname"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	DeletedAt   time.Time    `json:"deletedAt"`
+	Failure     []byte       `json:"failure,omitempty"`
+}
+
+func encodeInstance(instance store.Instance) ([]byte, error) {
+	r := record{
+		ID:          instance.ID,
+		Owner:       instance.Owner,
+		Component:   instance.Component,
+		GroupID:     instance.GroupID,
+		MachineType: instance.MachineType,
+		Status:      instance.Status,
+		Hostname:    instance.Hostname,
+		CreatedAt:   instance.CreatedAt,
+		DeletedAt:   instance.DeletedAt,
+	}
+	var err error
+	if r.Request, err = proto.Marshal(instance.Request); err != nil {
+		return nil, fmt.Errorf("failed to encode request: %s", err)
+	}
+	if r.Config, err = proto.Marshal(instance.Config); err != nil {
+		return nil, fmt.Errorf("failed to encode config: %s", err)
+	}
+	if r.Resources, err = proto.Marshal(instance.Resources); err != nil {
+		return nil, fmt.Errorf("failed to encode resources: %s", err)
+	}
+	if instance.Failure != nil {
+		if r.Failure, err = proto.Marshal(instance.Failure); err != nil {
+			return nil, fmt.Errorf("failed to encode failure: %s", err)
+		}
+	}
+	return json.Marshal(r)
+}
+
+func decodeInstance(data []byte) (store.Instance, error) {
+	var r record
+	if err := json.Unmarshal(data, &r); err != nil {
+		return store.Instance{}, fmt.Errorf("failed to decode instance: %s", err)
+	}
+	instance := store.Instance{
+		ID:          r.ID,
+		Owner:       r.Owner,
+		Component:   r.Component,
+		GroupID:     r.GroupID,
+		Request:     &genesis.CreateInstanceRequest{},
+		Config:      &genesis.InstanceConfig{},
+		MachineType: r.MachineType,
+		Resources:   &genesis.InstanceResources{},
+		Status:      r.Status,
+		Hostname:    r.Hostname,
+		CreatedAt:   r.CreatedAt,
+		DeletedAt:   r.DeletedAt,
+	}
+	if err := proto.Unmarshal(r.Request, instance.Request); err != nil {
+		return store.Instance{}, fmt.Errorf("failed to decode request: %s", err)
+	}
+	if err := proto.Unmarshal(r.Config, instance.Config); err != nil {
+		return store.Instance{}, fmt.Errorf("failed to decode config: %s", err)
+	}
+	if err := proto.Unmarshal(r.Resources, instance.Resources); err != nil {
+		return store.Instance{}, fmt.Errorf("failed to decode resources: %s", err)
+	}
+	if r.Failure != nil {
+		instance.Failure = &genesis_messages.InstanceCreationFailedEvent{}
+		if err := proto.Unmarshal(r.Failure, instance.Failure); err != nil {
+			return store.Instance{}, fmt.Errorf("failed to decode failure: %s", err)
+		}
+	}
+	return instance, nil
+}
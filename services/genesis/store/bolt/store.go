@@ -0,0 +1,152 @@
+package bolt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.etcd.io/bbolt"
+	"go.taskfleet.io/services/genesis/store"
+)
+
+var bucketInstances = []byte("instances")
+
+// Store is a store which persists all instances in a single file using an embedded key-value
+// database. The file is locked while the store is open, i.e. the same file cannot be used by
+// multiple stores at the same time. The store is thread-safe.
+type Store struct {
+	db *bbolt.DB
+}
+
+// NewStore opens the store persisted at the specified path. If the file does not exist, it is
+// created. If the file is locked by another process, this function blocks until the given
+// timeout expires.
+func NewStore(path string, timeout time.Duration) (*Store, error) {
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: timeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database file %q: %s", path, err)
+	}
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketInstances)
+		return err
+	}); err != nil {
+		db.Close() // nolint:errcheck
+		return nil, fmt.Errorf("failed to initialize database: %s", err)
+	}
+	return &Store{db}, nil
+}
+
+//-------------------------------------------------------------------------------------------------
+// STORE
+//-------------------------------------------------------------------------------------------------
+
+// Create implements the store.Store interface.
+func (s *Store) Create(ctx context.Context, instance store.Instance) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(bucketInstances)
+		if bucket.Get(instance.ID[:]) != nil {
+			return fmt.Errorf(
+				"failed to create instance %s: %w", instance.ID, store.ErrAlreadyExists,
+			)
+		}
+		return put(bucket, instance)
+	})
+}
+
+// Get implements the store.Store interface.
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (store.Instance, error) {
+	var instance store.Instance
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket(bucketInstances).Get(id[:])
+		if data == nil {
+			return fmt.Errorf("failed to get instance %s: %w", id, store.ErrNotFound)
+		}
+		var err error
+		instance, err = decodeInstance(data)
+		return err
+	})
+	return instance, err
+}
+
+// Update implements the store.Store interface.
+func (s *Store) Update(ctx context.Context, instance store.Instance) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(bucketInstances)
+		if bucket.Get(instance.ID[:]) == nil {
+			return fmt.Errorf("failed to update instance %s: %w", instance.ID, store.ErrNotFound)
+		}
+		return put(bucket, instance)
+	})
+}
+
+// UpdateIf implements the store.Store interface.
+func (s *Store) UpdateIf(ctx context.Context, instance store.Instance, status store.Status) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(bucketInstances)
+		data := bucket.Get(instance.ID[:])
+		if data == nil {
+			return fmt.Errorf("failed to update instance %s: %w", instance.ID, store.ErrNotFound)
+		}
+		current, err := decodeInstance(data)
+		if err != nil {
+			return err
+		}
+		if current.Status != status {
+			return fmt.Errorf(
+				"failed to update instance %s: %w", instance.ID, store.ErrStatusChanged,
+			)
+		}
+		return put(bucket, instance)
+	})
+}
+
+// Delete implements the store.Store interface.
+func (s *Store) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(bucketInstances)
+		if bucket.Get(id[:]) == nil {
+			return fmt.Errorf("failed to delete instance %s: %w", id, store.ErrNotFound)
+		}
+		return bucket.Delete(id[:])
+	})
+}
+
+// List implements the store.Store interface.
+func (s *Store) List(ctx context.Context, filter store.Filter) ([]store.Instance, error) {
+	result := []store.Instance{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucketInstances).ForEach(func(k, v []byte) error {
+			instance, err := decodeInstance(v)
+			if err != nil {
+				return err
+			}
+			if filter.Matches(instance) {
+				result = append(result, instance)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	store.SortByCreation(result)
+	return result, nil
+}
+
+// Close implements the store.Store interface.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+//-------------------------------------------------------------------------------------------------
+// UTILITIES
+//-------------------------------------------------------------------------------------------------
+
+func put(bucket *bbolt.Bucket, instance store.Instance) error {
+	data, err := encodeInstance(instance)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(instance.ID[:], data)
+}
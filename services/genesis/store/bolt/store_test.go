@@ -0,0 +1,141 @@
+package bolt
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/services/genesis/store"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewStore(filepath.Join(t.TempDir(), "genesis.db"), time.Second)
+	require.Nil(t, err)
+	defer s.Close() // nolint:errcheck
+
+	first := newInstance("owner", time.Now())
+	second := newInstance("other", first.CreatedAt.Add(time.Second))
+	second.Status = store.StatusRunning
+	require.Nil(t, s.Create(ctx, first))
+	require.Nil(t, s.Create(ctx, second))
+	assert.ErrorIs(t, s.Create(ctx, first), store.ErrAlreadyExists)
+
+	// Get
+	instance, err := s.Get(ctx, first.ID)
+	require.Nil(t, err)
+	assertInstanceEqual(t, first, instance)
+	_, err = s.Get(ctx, uuid.New())
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	// List
+	instances, err := s.List(ctx, store.Filter{Owner: "owner"})
+	require.Nil(t, err)
+	require.Len(t, instances, 1)
+	assertInstanceEqual(t, first, instances[0])
+	instances, err = s.List(ctx, store.Filter{})
+	require.Nil(t, err)
+	require.Len(t, instances, 2)
+	assert.Equal(t, first.ID, instances[0].ID)
+	assert.Equal(t, second.ID, instances[1].ID)
+
+	// Update
+	first.Status = store.StatusRunning
+	first.Hostname = "instance.internal"
+	require.Nil(t, s.Update(ctx, first))
+	instance, err = s.Get(ctx, first.ID)
+	require.Nil(t, err)
+	assertInstanceEqual(t, first, instance)
+	assert.ErrorIs(t, s.Update(ctx, newInstance("owner", time.Now())), store.ErrNotFound)
+
+	// Conditional update
+	first.Status = store.StatusDeleted
+	assert.ErrorIs(t, s.UpdateIf(ctx, first, store.StatusPending), store.ErrStatusChanged)
+	require.Nil(t, s.UpdateIf(ctx, first, store.StatusRunning))
+	assert.ErrorIs(t, s.UpdateIf(ctx, first, store.StatusRunning), store.ErrStatusChanged)
+	assert.ErrorIs(t,
+		s.UpdateIf(ctx, newInstance("owner", time.Now()), store.StatusRunning), store.ErrNotFound,
+	)
+
+	// Delete
+	require.Nil(t, s.Delete(ctx, first.ID))
+	assert.ErrorIs(t, s.Delete(ctx, first.ID), store.ErrNotFound)
+}
+
+func TestStorePersistence(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "genesis.db")
+
+	s, err := NewStore(path, time.Second)
+	require.Nil(t, err)
+	instance := newInstance("owner", time.Now())
+	instance.GroupID = uuid.New()
+	require.Nil(t, s.Create(ctx, instance))
+	failed := newInstance("owner", time.Now())
+	failed.Status = store.StatusFailed
+	failed.Failure = &genesis_messages.InstanceCreationFailedEvent{
+		Reason:  genesis_messages.InstanceCreationFailedEvent_REASON_QUOTA_EXCEEDED,
+		Message: "quota exceeded",
+	}
+	require.Nil(t, s.Create(ctx, failed))
+	require.Nil(t, s.Close())
+
+	s, err = NewStore(path, time.Second)
+	require.Nil(t, err)
+	defer s.Close() // nolint:errcheck
+	persisted, err := s.Get(ctx, instance.ID)
+	require.Nil(t, err)
+	assertInstanceEqual(t, instance, persisted)
+	persisted, err = s.Get(ctx, failed.ID)
+	require.Nil(t, err)
+	assertInstanceEqual(t, failed, persisted)
+}
+
+//-------------------------------------------------------------------------------------------------
+
+func newInstance(owner string, createdAt time.Time) store.Instance {
+	id := uuid.New()
+	config := &genesis.InstanceConfig{
+		CloudProvider: genesis.CloudProvider_CLOUD_PROVIDER_GOOGLE_CLOUD_PLATFORM,
+		Zone:          "europe-west1-b",
+	}
+	resources := &genesis.InstanceResources{CpuCount: 4, Memory: 16384}
+	return store.Instance{
+		ID:        id,
+		Owner:     owner,
+		Component: "worker",
+		Request: &genesis.CreateInstanceRequest{
+			Id:        id.String(),
+			Owner:     owner,
+			Component: "worker",
+			Config:    config,
+			Resources: resources,
+		},
+		Config:    config,
+		Resources: resources,
+		Status:    store.StatusPending,
+		CreatedAt: createdAt,
+	}
+}
+
+func assertInstanceEqual(t *testing.T, expected, actual store.Instance) {
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.Owner, actual.Owner)
+	assert.Equal(t, expected.Component, actual.Component)
+	assert.Equal(t, expected.GroupID, actual.GroupID)
+	assert.True(t, proto.Equal(expected.Request, actual.Request))
+	assert.True(t, proto.Equal(expected.Config, actual.Config))
+	assert.True(t, proto.Equal(expected.Resources, actual.Resources))
+	assert.Equal(t, expected.Status, actual.Status)
+	assert.Equal(t, expected.Hostname, actual.Hostname)
+	assert.True(t, expected.CreatedAt.Equal(actual.CreatedAt))
+	assert.True(t, expected.DeletedAt.Equal(actual.DeletedAt))
+	assert.True(t, proto.Equal(expected.Failure, actual.Failure))
+}
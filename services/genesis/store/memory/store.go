@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.taskfleet.io/services/genesis/store"
+)
+
+// Store is a store which keeps all instances in memory. It can be used for testing purposes or
+// whenever the state of Genesis does not need to survive restarts. The store is thread-safe.
+type Store struct {
+	mutex     sync.RWMutex
+	instances map[uuid.UUID]store.Instance
+}
+
+// NewStore initializes a new, empty in-memory store.
+func NewStore() *Store {
+	return &Store{instances: map[uuid.UUID]store.Instance{}}
+}
+
+// Create implements the store.Store interface.
+func (s *Store) Create(ctx context.Context, instance store.Instance) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.instances[instance.ID]; ok {
+		return fmt.Errorf("failed to create instance %s: %w", instance.ID, store.ErrAlreadyExists)
+	}
+	s.instances[instance.ID] = instance
+	return nil
+}
+
+// Get implements the store.Store interface.
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (store.Instance, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	instance, ok := s.instances[id]
+	if !ok {
+		return store.Instance{}, fmt.Errorf("failed to get instance %s: %w", id, store.ErrNotFound)
+	}
+	return instance, nil
+}
+
+// Update implements the store.Store interface.
+func (s *Store) Update(ctx context.Context, instance store.Instance) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.instances[instance.ID]; !ok {
+		return fmt.Errorf("failed to update instance %s: %w", instance.ID, store.ErrNotFound)
+	}
+	s.instances[instance.ID] = instance
+	return nil
+}
+
+// UpdateIf implements the store.Store interface.
+func (s *Store) UpdateIf(ctx context.Context, instance store.Instance, status store.Status) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	current, ok := s.instances[instance.ID]
+	if !ok {
+		return fmt.Errorf("failed to update instance %s: %w", instance.ID, store.ErrNotFound)
+	}
+	if current.Status != status {
+		return fmt.Errorf("failed to update instance %s: %w", instance.ID, store.ErrStatusChanged)
+	}
+	s.instances[instance.ID] = instance
+	return nil
+}
+
+// Delete implements the store.Store interface.
+func (s *Store) Delete(ctx context.Context, id uuid.UUID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.instances[id]; !ok {
+		return fmt.Errorf("failed to delete instance %s: %w", id, store.ErrNotFound)
+	}
+	delete(s.instances, id)
+	return nil
+}
+
+// List implements the store.Store interface.
+func (s *Store) List(ctx context.Context, filter store.Filter) ([]store.Instance, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	result := []store.Instance{}
+	for _, instance := range s.instances {
+		if filter.Matches(instance) {
+			result = append(result, instance)
+		}
+	}
+	store.SortByCreation(result)
+	return result, nil
+}
+
+// Close implements the store.Store interface.
+func (s *Store) Close() error {
+	return nil
+}
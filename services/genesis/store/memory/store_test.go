@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"go.taskfleet.io/packages/jack"
+	"go.taskfleet.io/services/genesis/store"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+
+	first := store.Instance{ID: uuid.New(), Owner: "owner", CreatedAt: time.Now()}
+	second := store.Instance{
+		ID:    uuid.New(),
+		Owner: "other",
+		Request: &genesis.CreateInstanceRequest{
+			Labels: map[string]string{"job": "training", "team": "ml"},
+		},
+		Config:    &genesis.InstanceConfig{Zone: "europe-west1-b", IsSpot: true},
+		Status:    store.StatusRunning,
+		CreatedAt: first.CreatedAt.Add(time.Second),
+	}
+	require.Nil(t, s.Create(ctx, first))
+	require.Nil(t, s.Create(ctx, second))
+	assert.ErrorIs(t, s.Create(ctx, first), store.ErrAlreadyExists)
+
+	// Get
+	instance, err := s.Get(ctx, first.ID)
+	require.Nil(t, err)
+	assert.Equal(t, first, instance)
+	_, err = s.Get(ctx, uuid.New())
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	// List
+	instances, err := s.List(ctx, store.Filter{})
+	require.Nil(t, err)
+	assert.Equal(t, []store.Instance{first, second}, instances)
+	instances, err = s.List(ctx, store.Filter{Owner: "owner"})
+	require.Nil(t, err)
+	assert.Equal(t, []store.Instance{first}, instances)
+	instances, err = s.List(ctx, store.Filter{Statuses: []store.Status{store.StatusRunning}})
+	require.Nil(t, err)
+	assert.Equal(t, []store.Instance{second}, instances)
+	instances, err = s.List(ctx, store.Filter{Labels: map[string]string{"job": "training"}})
+	require.Nil(t, err)
+	assert.Equal(t, []store.Instance{second}, instances)
+	instances, err = s.List(ctx, store.Filter{Labels: map[string]string{"job": "inference"}})
+	require.Nil(t, err)
+	assert.Empty(t, instances)
+	instances, err = s.List(ctx, store.Filter{Zone: "europe-west1-b", IsSpot: jack.Ptr(true)})
+	require.Nil(t, err)
+	assert.Equal(t, []store.Instance{second}, instances)
+	instances, err = s.List(ctx, store.Filter{IsSpot: jack.Ptr(false)})
+	require.Nil(t, err)
+	assert.Equal(t, []store.Instance{first}, instances)
+
+	// Update
+	first.Status = store.StatusRunning
+	require.Nil(t, s.Update(ctx, first))
+	instance, err = s.Get(ctx, first.ID)
+	require.Nil(t, err)
+	assert.Equal(t, store.StatusRunning, instance.Status)
+	assert.ErrorIs(t, s.Update(ctx, store.Instance{ID: uuid.New()}), store.ErrNotFound)
+
+	// Conditional update
+	first.Status = store.StatusDeleted
+	assert.ErrorIs(t, s.UpdateIf(ctx, first, store.StatusPending), store.ErrStatusChanged)
+	require.Nil(t, s.UpdateIf(ctx, first, store.StatusRunning))
+	assert.ErrorIs(t, s.UpdateIf(ctx, first, store.StatusRunning), store.ErrStatusChanged)
+	assert.ErrorIs(t,
+		s.UpdateIf(ctx, store.Instance{ID: uuid.New()}, store.StatusRunning), store.ErrNotFound,
+	)
+
+	// Delete
+	require.Nil(t, s.Delete(ctx, first.ID))
+	assert.ErrorIs(t, s.Delete(ctx, first.ID), store.ErrNotFound)
+	instances, err = s.List(ctx, store.Filter{})
+	require.Nil(t, err)
+	assert.Equal(t, []store.Instance{second}, instances)
+}
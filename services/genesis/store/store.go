@@ -0,0 +1,178 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	genesis_messages "go.taskfleet.io/grpc/gen/go/genesis/messages/v1"
+	genesis "go.taskfleet.io/grpc/gen/go/genesis/v1"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	// ErrNotFound is returned by stores if a referenced instance does not exist.
+	ErrNotFound = errors.New("instance not found")
+	// ErrAlreadyExists is returned by stores if an instance with the same ID already exists.
+	ErrAlreadyExists = errors.New("instance already exists")
+	// ErrStatusChanged is returned by stores if a conditional update fails since the status of
+	// the instance changed concurrently.
+	ErrStatusChanged = errors.New("instance status changed")
+)
+
+// Store persists the instances managed by Genesis. Implementations must be safe for concurrent
+// use.
+type Store interface {
+	// Create adds the given instance to the store. If an instance with the same ID already
+	// exists, the returned error wraps `ErrAlreadyExists`.
+	Create(ctx context.Context, instance Instance) error
+
+	// Get returns the instance with the specified ID. If it does not exist, the returned error
+	// wraps `ErrNotFound`.
+	Get(ctx context.Context, id uuid.UUID) (Instance, error)
+
+	// Update replaces the instance with the same ID as the given instance. If it does not exist,
+	// the returned error wraps `ErrNotFound`.
+	Update(ctx context.Context, instance Instance) error
+
+	// UpdateIf replaces the instance with the same ID as the given instance if its currently
+	// stored status equals the provided one. This allows status transitions to be performed by a
+	// single caller even if multiple callers attempt them concurrently. If the stored status
+	// differs, the returned error wraps `ErrStatusChanged`. If the instance does not exist, the
+	// returned error wraps `ErrNotFound`.
+	UpdateIf(ctx context.Context, instance Instance, status Status) error
+
+	// Delete removes the instance with the specified ID. If it does not exist, the returned error
+	// wraps `ErrNotFound`.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// List returns all instances matching the provided filter, ordered by their creation time.
+	List(ctx context.Context, filter Filter) ([]Instance, error)
+
+	// Close releases all resources held by the store.
+	Close() error
+}
+
+//-------------------------------------------------------------------------------------------------
+// TYPES
+//-------------------------------------------------------------------------------------------------
+
+// Status describes the lifecycle status of an instance.
+type Status int
+
+const (
+	// StatusPending indicates that the instance was requested but is not running yet.
+	StatusPending Status = iota
+	// StatusRunning indicates that the instance is up and running.
+	StatusRunning
+	// StatusFailed indicates that the instance could not be created.
+	StatusFailed
+	// StatusDeleted indicates that the instance was running and has been deleted.
+	StatusDeleted
+)
+
+// Instance describes an instance that was requested from Genesis.
+type Instance struct {
+	// The globally unique identifier of the instance.
+	ID uuid.UUID
+	// The owner which requested the instance.
+	Owner string
+	// The component for which the instance was requested.
+	Component string
+	// The ID of the group to which the instance belongs or `uuid.Nil` if the instance was
+	// requested individually.
+	GroupID uuid.UUID
+	// The original request that was used to request the instance.
+	Request *genesis.CreateInstanceRequest
+	// The actual configuration of the instance.
+	Config *genesis.InstanceConfig
+	// The provider-specific machine type of the instance. Empty if the provider chose the
+	// machine type.
+	MachineType string
+	// The actual resources of the instance.
+	Resources *genesis.InstanceResources
+	// The current lifecycle status of the instance.
+	Status Status
+	// The hostname of the instance. Only set once the instance is running.
+	Hostname string
+	// The time at which the instance was requested.
+	CreatedAt time.Time
+	// The time at which the instance failed to be created or was deleted.
+	DeletedAt time.Time
+	// The reason why the instance failed to be created. Only set if the instance failed.
+	Failure *genesis_messages.InstanceCreationFailedEvent
+}
+
+// Filter allows to restrict the instances returned when listing instances. Zero values do not
+// restrict the result.
+type Filter struct {
+	// The owner of the instances.
+	Owner string
+	// The statuses which instances may have.
+	Statuses []Status
+	// The labels which instances must carry, i.e. the labels passed in the instances' requests.
+	Labels map[string]string
+	// The component of the instances.
+	Component string
+	// The cloud provider of the instances.
+	CloudProvider genesis.CloudProvider
+	// The zone of the instances.
+	Zone string
+	// The kind of GPUs attached to the instances.
+	GPUKind genesis.GPUKind
+	// Whether the instances are spot instances.
+	IsSpot *bool
+	// The ID of the group to which the instances belong.
+	GroupID uuid.UUID
+}
+
+// Matches returns whether the provided instance matches the filter.
+func (f Filter) Matches(instance Instance) bool {
+	if f.Owner != "" && instance.Owner != f.Owner {
+		return false
+	}
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, instance.Status) {
+		return false
+	}
+	if f.Component != "" && instance.Component != f.Component {
+		return false
+	}
+	if f.CloudProvider != genesis.CloudProvider_CLOUD_PROVIDER_UNSPECIFIED &&
+		instance.Config.GetCloudProvider() != f.CloudProvider {
+		return false
+	}
+	if f.Zone != "" && instance.Config.GetZone() != f.Zone {
+		return false
+	}
+	if f.GPUKind != genesis.GPUKind_GPU_KIND_UNSPECIFIED &&
+		instance.Resources.GetGpu().GetKind() != f.GPUKind {
+		return false
+	}
+	if f.IsSpot != nil && instance.Config.GetIsSpot() != *f.IsSpot {
+		return false
+	}
+	if f.GroupID != uuid.Nil && instance.GroupID != f.GroupID {
+		return false
+	}
+	labels := instance.Request.GetLabels()
+	for key, value := range f.Labels {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// SortByCreation sorts the given instances by their creation time. Instances that were created at
+// the same time are sorted by their ID.
+func SortByCreation(instances []Instance) {
+	sort.Slice(instances, func(i, j int) bool {
+		if instances[i].CreatedAt.Equal(instances[j].CreatedAt) {
+			return bytes.Compare(instances[i].ID[:], instances[j].ID[:]) < 0
+		}
+		return instances[i].CreatedAt.Before(instances[j].CreatedAt)
+	})
+}